@@ -0,0 +1,217 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+	"zgo.at/uni/v2/unidata"
+	"zgo.at/zli"
+)
+
+var (
+	cleanPolicies = []string{"bidi", "invisible", "spaces", "newlines", "control",
+		"nfc", "nfkc", "unassigned"}
+	defaultCleanPolicy = "bidi,invisible,spaces,newlines,control,nfc,unassigned"
+)
+
+type cleaner struct {
+	policy  map[string]bool
+	changes changes
+}
+
+// changes records what was changed in the text, for the summary.
+type (
+	changes map[string]*change
+	change  struct {
+		action   string // removed, replaced, normalized
+		from, to string
+		n        int
+		first    int // Order of first occurrence, for the summary.
+	}
+)
+
+func clean(args []string, policy string, quiet bool) error {
+	c := cleaner{policy: make(map[string]bool), changes: make(changes)}
+	for _, p := range strings.Split(policy, ",") {
+		p = strings.TrimSpace(p)
+		if p == "" {
+			continue
+		}
+		if p == "all" {
+			for _, pp := range cleanPolicies {
+				c.policy[pp] = pp != "nfkc"
+			}
+			continue
+		}
+		m, err := match(p, cleanPolicies...)
+		if err != nil {
+			return fmt.Errorf("unknown value for -policy: %q", p)
+		}
+		c.policy[m] = true
+	}
+	if c.policy["nfc"] && c.policy["nfkc"] {
+		return fmt.Errorf("can't use both nfc and nfkc for -policy")
+	}
+
+	// Write the text as-is when reading from stdin, so that cleaning a file
+	// twice gives the same result.
+	var text string
+	if len(args) > 0 {
+		text = strings.Join(args, " ") + "\n"
+	} else {
+		if fp, ok := zli.Stdin.(*os.File); ok && zli.IsTerminal(fp.Fd()) {
+			return errors.New("clean: need text as arguments or on stdin")
+		}
+		in, err := io.ReadAll(zli.Stdin)
+		if err != nil {
+			return err
+		}
+		text = string(in)
+	}
+
+	fmt.Fprint(zli.Stdout, c.clean(text))
+	if !quiet {
+		c.changes.summary(zli.Stderr, "clean")
+	}
+	return nil
+}
+
+func (c changes) add(action string, from, to string) {
+	k := action + from + "\x00" + to
+	ch, ok := c[k]
+	if !ok {
+		ch = &change{action: action, from: from, to: to, first: len(c)}
+		c[k] = ch
+	}
+	ch.n++
+}
+
+func (c *cleaner) clean(s string) string {
+	var (
+		b     = new(strings.Builder)
+		runes = []rune(s)
+	)
+	b.Grow(len(s))
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		var prev, next rune
+		if i > 0 {
+			prev = runes[i-1]
+		}
+		if i < len(runes)-1 {
+			next = runes[i+1]
+		}
+
+		switch {
+		case r < 0x80 && r >= 0x20 && r != 0x7f, r == '\n', r == '\t': // Common case.
+
+		case c.policy["newlines"] && r == '\r' && next == '\n':
+			c.changes.add("replaced", "\r\n", "\n")
+			i++
+			r = '\n'
+		case c.policy["newlines"] && (r == '\r' || r == 0x85 || r == 0x2028 || r == 0x2029):
+			c.changes.add("replaced", string(r), "\n")
+			r = '\n'
+		case c.policy["control"] && (r < 0x20 || (r >= 0x7f && r <= 0x9f)):
+			c.changes.add("removed", string(r), "")
+			continue
+		case c.policy["bidi"] && bidiControl.contains(r):
+			c.changes.add("removed", string(r), "")
+			continue
+		case c.policy["invisible"] && defaultIgnorable.contains(r) && !bidiControl.contains(r) &&
+			!ignorableOK(prev, r, next):
+			c.changes.add("removed", string(r), "")
+			continue
+		case c.policy["spaces"] && r != ' ' && isSpaceSep(r):
+			c.changes.add("replaced", string(r), " ")
+			r = ' '
+		case c.policy["unassigned"] && r > 0x7f:
+			info, ok := unidata.Find(r)
+			if cat := info.Category(); !ok || cat == unidata.CatUnassigned || cat == unidata.CatPrivateUse {
+				c.changes.add("replaced", string(r), "�")
+				r = '�'
+			}
+		}
+		b.WriteRune(r)
+	}
+
+	switch {
+	case c.policy["nfc"]:
+		return c.normalize(b.String(), norm.NFC)
+	case c.policy["nfkc"]:
+		return c.normalize(b.String(), norm.NFKC)
+	}
+	return b.String()
+}
+
+func isSpaceSep(r rune) bool {
+	if r < 0x80 {
+		return false
+	}
+	info, _ := unidata.Find(r)
+	return info.Category() == unidata.CatSpaceSeparator
+}
+
+// normalize the text, recording every segment that was changed.
+func (c *cleaner) normalize(s string, form norm.Form) string {
+	if form.IsNormalString(s) {
+		return s
+	}
+
+	b := new(strings.Builder)
+	b.Grow(len(s))
+	for s != "" {
+		n := form.NextBoundaryInString(s, true)
+		seg, nseg := s[:n], form.String(s[:n])
+		if seg != nseg {
+			c.changes.add("normalized", seg, nseg)
+		}
+		b.WriteString(nseg)
+		s = s[n:]
+	}
+	return b.String()
+}
+
+// summary writes all changes to out, in the order they first occurred.
+func (c changes) summary(out io.Writer, cmd string) {
+	if len(c) == 0 {
+		return
+	}
+
+	list := make([]*change, 0, len(c))
+	for _, ch := range c {
+		list = append(list, ch)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].first < list[j].first })
+
+	for _, ch := range list {
+		switch ch.action {
+		case "removed":
+			fmt.Fprintf(out, "uni %s: removed %d× %s\n", cmd, ch.n, describeCodepoints(ch.from))
+		default:
+			fmt.Fprintf(out, "uni %s: %s %d× %s → %s\n", cmd, ch.action, ch.n, describeCodepoints(ch.from), describeCodepoints(ch.to))
+		}
+	}
+}
+
+// describeCodepoints describes all codepoints in s as "U+0041 LATIN CAPITAL
+// LETTER A", separated by commas.
+func describeCodepoints(s string) string {
+	d := make([]string, 0, 1)
+	for _, r := range s {
+		info, _ := unidata.Find(r)
+		n := info.FormatCodepoint() + " " + info.Name()
+		// Ideographs don't have a useful name, so add the character.
+		if unicode.Is(unicode.Ideographic, r) {
+			n += " (" + string(r) + ")"
+		}
+		d = append(d, n)
+	}
+	return strings.Join(d, ", ")
+}
@@ -0,0 +1,173 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/rivo/uniseg"
+	"zgo.at/termtext"
+	"zgo.at/zli"
+)
+
+var countUnits = []string{"utf8", "bytes=utf8", "utf16", "codepoints", "runes=codepoints",
+	"graphemes", "cells"}
+
+type counts struct {
+	Line       int `json:"line,omitempty"`
+	UTF8       int `json:"utf8"`
+	UTF16      int `json:"utf16"`
+	Codepoints int `json:"codepoints"`
+	Graphemes  int `json:"graphemes"`
+	Cells      int `json:"cells"`
+}
+
+func (c counts) get(unit string) int {
+	switch unit {
+	case "utf8":
+		return c.UTF8
+	case "utf16":
+		return c.UTF16
+	case "codepoints":
+		return c.Codepoints
+	case "graphemes":
+		return c.Graphemes
+	default:
+		return c.Cells
+	}
+}
+
+// count the length of s in all units; the function is called after every
+// grapheme with the count up to and including that grapheme, and can return
+// false to stop.
+func count(s string, fun func(g string, c counts) bool) counts {
+	var (
+		c   counts
+		col int // Column for tabs.
+		g   = uniseg.NewGraphemes(s)
+	)
+	for g.Next() {
+		str := g.Str()
+		n, w := c, 0
+		n.UTF8 += len(str)
+		for _, r := range g.Runes() {
+			n.Codepoints++
+			n.UTF16++
+			if r > 0xffff {
+				n.UTF16++
+			}
+		}
+		n.Graphemes++
+		switch str {
+		case "\t":
+			w = termtext.TabWidth - col%termtext.TabWidth
+		case "\n", "\r\n":
+			col = 0
+		default:
+			w = termtext.Width(str)
+		}
+		n.Cells += w
+
+		if fun != nil && !fun(str, n) {
+			break
+		}
+		c, col = n, col+w
+	}
+	return c
+}
+
+// truncate s to at most limit in the given unit, without splitting grapheme
+// clusters.
+func truncate(s string, limit int, unit string) string {
+	b := new(strings.Builder)
+	count(s, func(g string, c counts) bool {
+		if c.get(unit) > limit {
+			return false
+		}
+		b.WriteString(g)
+		return true
+	})
+	return b.String()
+}
+
+// parseTruncate parses the -truncate flag, in the form of "n" or "n:unit".
+func parseTruncate(s string) (int, string, error) {
+	unit := "graphemes"
+	if i := strings.IndexByte(s, ':'); i > -1 {
+		var err error
+		unit, err = match(s[i+1:], countUnits...)
+		if err != nil {
+			return 0, "", fmt.Errorf("unknown unit for -truncate: %q", s[i+1:])
+		}
+		s = s[:i]
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil || n < 0 {
+		return 0, "", fmt.Errorf("invalid value for -truncate: %q", s)
+	}
+	return n, unit, nil
+}
+
+func countCmd(args []string, as printAs, lines bool, trunc string) error {
+	var text []string
+	if lines {
+		text = strings.Split(strings.Join(args, " "), "\n")
+		for i := range text {
+			text[i] = strings.TrimSuffix(text[i], "\r")
+		}
+	} else {
+		text = []string{strings.Join(args, " ")}
+	}
+
+	if trunc != "" {
+		limit, unit, err := parseTruncate(trunc)
+		if err != nil {
+			return err
+		}
+		for _, t := range text {
+			fmt.Fprintln(zli.Stdout, truncate(t, limit, unit))
+		}
+		return nil
+	}
+
+	all := make([]counts, 0, len(text))
+	for i, t := range text {
+		c := count(t, nil)
+		if lines {
+			c.Line = i + 1
+		}
+		all = append(all, c)
+	}
+
+	switch as {
+	case printAsJSON, printAsJSONCompact:
+		if lines {
+			writeJSON(zli.Stdout, all, as == printAsJSONCompact)
+		} else {
+			writeJSON(zli.Stdout, all[0], as == printAsJSONCompact)
+		}
+	case printAsListCompact:
+		for _, c := range all {
+			if lines {
+				fmt.Fprintf(zli.Stdout, "%d ", c.Line)
+			}
+			fmt.Fprintf(zli.Stdout, "%d %d %d %d %d\n", c.UTF8, c.UTF16, c.Codepoints, c.Graphemes, c.Cells)
+		}
+	case printAsList:
+		if !lines {
+			c := all[0]
+			fmt.Fprintf(zli.Stdout, "utf-8       %d\nutf-16      %d\ncodepoints  %d\ngraphemes   %d\ncells       %d\n",
+				c.UTF8, c.UTF16, c.Codepoints, c.Graphemes, c.Cells)
+			return nil
+		}
+		fmt.Fprintln(zli.Stdout, "line  utf-8  utf-16  codepoints  graphemes  cells")
+		for _, c := range all {
+			fmt.Fprintf(zli.Stdout, "%4d  %5d  %6d  %10d  %9d  %5d\n",
+				c.Line, c.UTF8, c.UTF16, c.Codepoints, c.Graphemes, c.Cells)
+		}
+	default:
+		return errors.New("count only supports -as list and json")
+	}
+	return nil
+}
@@ -0,0 +1,355 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"unicode"
+
+	"github.com/rivo/uniseg"
+	"golang.org/x/text/unicode/norm"
+	"zgo.at/termtext"
+	"zgo.at/uni/v2/unidata"
+	"zgo.at/zli"
+)
+
+// errDiffFound is returned if the strings are different; this doesn't print
+// an error, but exits with 1.
+var errDiffFound = fmt.Errorf("diff: strings are different: %w", errSilent)
+
+// Maximum number of comparisons to align both inputs (len(a)×len(b)); anything
+// larger is reported as one difference.
+const maxDiffWork = 1 << 26
+
+type (
+	diffHunk struct {
+		Kind string   `json:"kind"`
+		A    diffSide `json:"a"`
+		B    diffSide `json:"b"`
+	}
+	diffSide struct {
+		Line       int             `json:"line"`
+		Col        int             `json:"col"` // In codepoints, starting at 1.
+		Codepoints []diffCodepoint `json:"codepoints"`
+
+		text string
+	}
+	diffCodepoint struct {
+		Cpoint string `json:"cpoint"`
+		Name   string `json:"name"`
+		Cat    string `json:"cat"`
+	}
+
+	// grapheme with its position.
+	diffGrapheme struct {
+		s         string
+		line, col int
+	}
+)
+
+func diffCmd(args []string, as printAs, files bool) error {
+	if len(args) != 2 {
+		if files {
+			return errors.New("diff -files needs two files")
+		}
+		return errors.New("diff needs two strings")
+	}
+	if files {
+		for i := range args {
+			b, err := os.ReadFile(args[i])
+			if err != nil {
+				return err
+			}
+			args[i] = string(b)
+		}
+	}
+
+	hunks := diffStrings(args[0], args[1])
+	switch as {
+	case printAsJSON, printAsJSONCompact:
+		if hunks == nil {
+			hunks = []diffHunk{}
+		}
+		writeJSON(zli.Stdout, hunks, as == printAsJSONCompact)
+	case printAsList, printAsListCompact:
+		for i, h := range hunks {
+			if i > 0 && as == printAsList {
+				fmt.Fprintln(zli.Stdout)
+			}
+			pos := fmt.Sprintf("%d:%d", h.A.Line, h.A.Col)
+			if h.A.Line != h.B.Line || h.A.Col != h.B.Col {
+				pos += fmt.Sprintf(" → %d:%d", h.B.Line, h.B.Col)
+			}
+			fmt.Fprintf(zli.Stdout, "%s: %s\n", pos, h.Kind)
+
+			var (
+				rows  [][]string
+				width = 2
+			)
+			for _, s := range []struct {
+				sign string
+				side diffSide
+			}{{"-", h.A}, {"+", h.B}} {
+				for _, r := range s.side.text {
+					info, _ := unidata.Find(r)
+					char := info.Display()
+					if m := visMarker(0, r, 0); m != "" {
+						char = "⟨" + m + "⟩"
+					}
+					if w := termtext.Width(char); w > width {
+						width = w
+					}
+					rows = append(rows, []string{s.sign, info.FormatCodepoint(), char,
+						unidata.Categories[info.Category()].ShortName, info.Name()})
+				}
+			}
+			for _, r := range rows {
+				fmt.Fprintf(zli.Stdout, "  %s %-8s %s  %s  %s\n", r[0], r[1], termtext.AlignLeft(r[2], width), r[3], r[4])
+			}
+		}
+	default:
+		return errors.New("diff only supports -as list and json")
+	}
+	if len(hunks) > 0 {
+		return errDiffFound
+	}
+	return nil
+}
+
+// diffStrings aligns the grapheme clusters in a and b, and returns all the
+// differences.
+func diffStrings(a, b string) []diffHunk {
+	ga, gb := diffGraphemes(a), diffGraphemes(b)
+
+	// Common prefix and suffix.
+	start := 0
+	for start < len(ga) && start < len(gb) && ga[start].s == gb[start].s {
+		start++
+	}
+	endA, endB := len(ga), len(gb)
+	for endA > start && endB > start && ga[endA-1].s == gb[endB-1].s {
+		endA, endB = endA-1, endB-1
+	}
+	ma, mb := ga[start:endA], gb[start:endB]
+
+	var hunks []diffHunk
+	add := func(i, j, ii, jj int) { // Difference from ma[i:ii] and mb[j:jj].
+		if i == ii && j == jj {
+			return
+		}
+		h := diffHunk{A: diffSide{text: diffJoin(ma[i:ii])}, B: diffSide{text: diffJoin(mb[j:jj])}}
+		h.A.Line, h.A.Col = diffPos(ga, start+i)
+		h.B.Line, h.B.Col = diffPos(gb, start+j)
+		h.A.Codepoints, h.B.Codepoints = diffCodepoints(h.A.text), diffCodepoints(h.B.text)
+		h.Kind = diffKind(h.A.text, h.B.text)
+		hunks = append(hunks, h)
+	}
+	if len(ma)*len(mb) > maxDiffWork {
+		add(0, 0, len(ma), len(mb))
+		return hunks
+	}
+
+	// Compare graphemes as numbers, rather than comparing the strings every
+	// time.
+	var (
+		ids    = make(map[string]int)
+		ia, ib = make([]int, len(ma)), make([]int, len(mb))
+	)
+	for i, g := range ma {
+		if _, ok := ids[g.s]; !ok {
+			ids[g.s] = len(ids)
+		}
+		ia[i] = ids[g.s]
+	}
+	for i, g := range mb {
+		if _, ok := ids[g.s]; !ok {
+			ids[g.s] = len(ids)
+		}
+		ib[i] = ids[g.s]
+	}
+
+	var hi, hj int
+	for _, m := range diffLCS(ia, ib, 0, 0, nil) {
+		add(hi, hj, m[0], m[1])
+		hi, hj = m[0]+1, m[1]+1
+	}
+	add(hi, hj, len(ma), len(mb))
+	return hunks
+}
+
+// diffLCS gets the index pairs of the longest common subsequence of a and b,
+// appended to matches. This uses Hirschberg's algorithm, which needs linear
+// space rather than a len(a)×len(b) table.
+func diffLCS(a, b []int, offA, offB int, matches [][2]int) [][2]int {
+	switch {
+	case len(a) == 0 || len(b) == 0:
+		return matches
+	case len(a) == 1:
+		for j := range b {
+			if a[0] == b[j] {
+				return append(matches, [2]int{offA, offB + j})
+			}
+		}
+		return matches
+	}
+
+	// Split a in half, and find where to split b so that the LCS of both
+	// halves is the longest.
+	var (
+		mid     = len(a) / 2
+		fwd     = diffLCSLen(a[:mid], b, false)
+		rev     = diffLCSLen(a[mid:], b, true)
+		best, k = -1, 0
+	)
+	for j := 0; j <= len(b); j++ {
+		if l := fwd[j] + rev[j]; l > best {
+			best, k = l, j
+		}
+	}
+	matches = diffLCS(a[:mid], b[:k], offA, offB, matches)
+	return diffLCS(a[mid:], b[k:], offA+mid, offB+k, matches)
+}
+
+// diffLCSLen gets the length of the LCS of a and b[:j] for every j, or of a
+// and b[j:] if rev is set.
+func diffLCSLen(a, b []int, rev bool) []int {
+	prev, cur := make([]int, len(b)+1), make([]int, len(b)+1)
+	for i := range a {
+		if rev {
+			i = len(a) - 1 - i
+			for j := len(b) - 1; j >= 0; j-- {
+				switch {
+				case a[i] == b[j]:
+					cur[j] = prev[j+1] + 1
+				case prev[j] >= cur[j+1]:
+					cur[j] = prev[j]
+				default:
+					cur[j] = cur[j+1]
+				}
+			}
+		} else {
+			for j := 1; j <= len(b); j++ {
+				switch {
+				case a[i] == b[j-1]:
+					cur[j] = prev[j-1] + 1
+				case prev[j] >= cur[j-1]:
+					cur[j] = prev[j]
+				default:
+					cur[j] = cur[j-1]
+				}
+			}
+		}
+		prev, cur = cur, prev
+	}
+	return prev
+}
+
+func diffGraphemes(s string) []diffGrapheme {
+	var (
+		g         = uniseg.NewGraphemes(s)
+		gr        = make([]diffGrapheme, 0, len(s))
+		line, col = 1, 1
+	)
+	for g.Next() {
+		str := g.Str()
+		gr = append(gr, diffGrapheme{s: str, line: line, col: col})
+		if str == "\n" || str == "\r\n" {
+			line, col = line+1, 1
+		} else {
+			col += len(g.Runes())
+		}
+	}
+	return gr
+}
+
+// diffPos gets the line and column of the grapheme at index i; this may be one
+// past the end.
+func diffPos(g []diffGrapheme, i int) (int, int) {
+	switch {
+	case i < len(g):
+		return g[i].line, g[i].col
+	case len(g) == 0:
+		return 1, 1
+	}
+	l := g[len(g)-1]
+	if l.s == "\n" || l.s == "\r\n" {
+		return l.line + 1, 1
+	}
+	return l.line, l.col + len([]rune(l.s))
+}
+
+func diffJoin(g []diffGrapheme) string {
+	b := new(strings.Builder)
+	for _, gg := range g {
+		b.WriteString(gg.s)
+	}
+	return b.String()
+}
+
+func diffCodepoints(s string) []diffCodepoint {
+	cps := make([]diffCodepoint, 0, len(s))
+	for _, r := range s {
+		info, _ := unidata.Find(r)
+		cps = append(cps, diffCodepoint{
+			Cpoint: info.FormatCodepoint(),
+			Name:   info.Name(),
+			Cat:    unidata.Categories[info.Category()].ShortName,
+		})
+	}
+	return cps
+}
+
+// diffKind classifies the difference between a and b.
+func diffKind(a, b string) string {
+	switch {
+	case diffStrip(a) == diffStrip(b):
+		return "invisible"
+	case norm.NFC.String(a) == norm.NFC.String(b):
+		return "normalization"
+	case diffSpace(a) && diffSpace(b):
+		return "whitespace"
+	case norm.NFKC.String(a) == norm.NFKC.String(b):
+		return "compatibility"
+	case skeleton(a) == skeleton(b):
+		return "confusable"
+	case a == "":
+		return "inserted"
+	case b == "":
+		return "deleted"
+	}
+	return "changed"
+}
+
+// diffStrip removes all invisible characters.
+func diffStrip(s string) string {
+	return strings.Map(func(r rune) rune {
+		if defaultIgnorable.contains(r) || bidiControl.contains(r) {
+			return -1
+		}
+		return r
+	}, s)
+}
+
+func diffSpace(s string) bool {
+	for _, r := range s {
+		if !unicode.IsSpace(r) && !isSpaceSep(r) {
+			return false
+		}
+	}
+	return s != ""
+}
+
+// skeleton gets the confusable skeleton from UTS #39: two strings are
+// confusable if their skeletons are identical.
+func skeleton(s string) string {
+	b := new(strings.Builder)
+	for _, r := range norm.NFD.String(s) {
+		if c := (unidata.Codepoint{Codepoint: r}).Confusable(); c != "" {
+			b.WriteString(c)
+		} else {
+			b.WriteRune(r)
+		}
+	}
+	return norm.NFD.String(b.String())
+}
@@ -0,0 +1,190 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"unicode"
+
+	"zgo.at/zli"
+)
+
+var exportTargets = []string{"go", "re2", "regexp=re2", "pcre", "js", "javascript=js",
+	"python", "c", "json"}
+
+func export(args []string, to string, compact bool) error {
+	if len(args) == 0 {
+		return errors.New("export: need a selection; see the print command")
+	}
+	if to == "" {
+		to = "go"
+	}
+	target, err := match(to, exportTargets...)
+	if err != nil {
+		return fmt.Errorf("unknown value for -to: %q", to)
+	}
+
+	var s rangeSet
+	for _, a := range args {
+		sel, err := selection(a)
+		if err != nil {
+			return err
+		}
+		s = s.union(sel)
+	}
+	if len(s) == 0 {
+		return errNoMatches
+	}
+
+	switch target {
+	case "go":
+		exportRangeTable(zli.Stdout, s)
+	case "re2", "pcre":
+		exportRegexp(zli.Stdout, s, `\x{%X}`, `\x{%X}`)
+	case "js":
+		exportRegexp(zli.Stdout, s, `\u{%X}`, `\u{%X}`)
+	case "python":
+		exportRegexp(zli.Stdout, s, `\u%04X`, `\U%08X`)
+	case "c":
+		exportC(zli.Stdout, s)
+	case "json":
+		exportJSON(zli.Stdout, s, compact)
+	}
+	return nil
+}
+
+// exportRangeTable writes the set as a Go unicode.RangeTable, using the same
+// layout as the tables in the unicode package.
+func exportRangeTable(out io.Writer, s rangeSet) {
+	var (
+		r16, r32 []unicode.Range32 // Use Range32 for both; just for convenience.
+		latin    int
+	)
+	for _, r := range strides(s) {
+		// Split ranges that cross 0xffff, like the unicode package does;
+		// strides() never makes a stride that crosses it.
+		if r.Lo <= 0xffff && r.Hi > 0xffff {
+			r16 = append(r16, unicode.Range32{Lo: r.Lo, Hi: 0xffff, Stride: 1})
+			r = unicode.Range32{Lo: 0x10000, Hi: r.Hi, Stride: 1}
+		}
+		if r.Hi <= 0xffff {
+			r16 = append(r16, r)
+			if r.Hi <= unicode.MaxLatin1 {
+				latin++
+			}
+		} else {
+			r32 = append(r32, r)
+		}
+	}
+
+	fmt.Fprintln(out, "var table = &unicode.RangeTable{")
+	if len(r16) > 0 {
+		fmt.Fprintln(out, "\tR16: []unicode.Range16{")
+		for _, r := range r16 {
+			fmt.Fprintf(out, "\t\t{0x%04x, 0x%04x, %d},\n", r.Lo, r.Hi, r.Stride)
+		}
+		fmt.Fprintln(out, "\t},")
+	}
+	if len(r32) > 0 {
+		fmt.Fprintln(out, "\tR32: []unicode.Range32{")
+		for _, r := range r32 {
+			fmt.Fprintf(out, "\t\t{0x%x, 0x%x, %d},\n", r.Lo, r.Hi, r.Stride)
+		}
+		fmt.Fprintln(out, "\t},")
+	}
+	if latin > 0 {
+		fmt.Fprintf(out, "\tLatinOffset: %d,\n", latin)
+	}
+	fmt.Fprintln(out, "}")
+}
+
+// strides compresses runs of single codepoints with the same distance in to
+// one range with a stride (e.g. U+0100, U+0102, U+0104 → {0x100, 0x104, 2}).
+func strides(s rangeSet) []unicode.Range32 {
+	rng := make([]unicode.Range32, 0, len(s))
+	for i := 0; i < len(s); i++ {
+		r := s[i]
+		single := func(j int) bool { return j < len(s) && s[j][0] == s[j][1] }
+
+		// Need at least three codepoints with the same stride, and don't mix
+		// Range16 and Range32.
+		if !single(i) || !single(i+1) || !single(i+2) ||
+			s[i+1][0]-r[0] != s[i+2][0]-s[i+1][0] || (r[0] > 0xffff) != (s[i+2][0] > 0xffff) {
+			rng = append(rng, unicode.Range32{Lo: uint32(r[0]), Hi: uint32(r[1]), Stride: 1})
+			continue
+		}
+
+		var (
+			stride = s[i+1][0] - r[0]
+			hi     = r[0]
+		)
+		for single(i+1) && s[i+1][0]-hi == stride && (hi > 0xffff) == (s[i+1][0] > 0xffff) {
+			i++
+			hi = s[i][0]
+		}
+		rng = append(rng, unicode.Range32{Lo: uint32(r[0]), Hi: uint32(hi), Stride: uint32(stride)})
+	}
+	return rng
+}
+
+// exportRegexp writes the set as a regular expression character class. Letters
+// and digits are written as-is, everything else is escaped with the format
+// strings. Surrogates are never included, since they can't be matched.
+func exportRegexp(out io.Writer, s rangeSet, bmp, astral string) {
+	s = s.subtract(rangeSet{{0xd800, 0xdfff}})
+
+	esc := func(r rune) string {
+		if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') {
+			return string(r)
+		}
+		if r > 0xffff {
+			return fmt.Sprintf(astral, r)
+		}
+		return fmt.Sprintf(bmp, r)
+	}
+
+	b := new(strings.Builder)
+	b.WriteByte('[')
+	for _, r := range s {
+		b.WriteString(esc(r[0]))
+		switch {
+		case r[1] == r[0]+1:
+			b.WriteString(esc(r[1]))
+		case r[1] > r[0]:
+			b.WriteByte('-')
+			b.WriteString(esc(r[1]))
+		}
+	}
+	b.WriteString("]\n")
+	out.Write([]byte(b.String()))
+}
+
+// exportC writes the set as a C array of ranges.
+func exportC(out io.Writer, s rangeSet) {
+	fmt.Fprintln(out, "static const struct { uint32_t start, end; } ranges[] = {")
+	for _, r := range s {
+		fmt.Fprintf(out, "\t{0x%04X, 0x%04X},\n", r[0], r[1])
+	}
+	fmt.Fprintln(out, "};")
+}
+
+// exportJSON writes the set as a JSON list of [start, end] pairs.
+func exportJSON(out io.Writer, s rangeSet, compact bool) {
+	b := new(strings.Builder)
+	b.WriteByte('[')
+	for i, r := range s {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		if !compact {
+			b.WriteString("\n\t")
+		}
+		fmt.Fprintf(b, "[%d,%d]", r[0], r[1])
+	}
+	if !compact && len(s) > 0 {
+		b.WriteByte('\n')
+	}
+	b.WriteString("]\n")
+	out.Write([]byte(b.String()))
+}
@@ -2,8 +2,11 @@ package main
 
 import (
 	"bytes"
+	"encoding/csv"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"html"
 	"io"
 	"math"
 	"os"
@@ -11,6 +14,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"text/template"
 	"unicode/utf8"
 
 	"github.com/mattn/go-runewidth"
@@ -33,6 +37,14 @@ var (
 		}
 		return w
 	}()
+
+	// Use numbers and arrays in JSON output instead of always using strings;
+	// set from the -typed flag.
+	typedJSON = false
+
+	// How to order results; set from the -sort, -reverse, -group-by, -limit,
+	// and -offset flags.
+	resultOrder ordering
 )
 
 const (
@@ -43,25 +55,50 @@ const (
 )
 
 type column struct {
-	name  string
-	width int
-	align int
-	trim  bool
-	quote bool
-	fill  rune
+	name     string
+	width    int
+	minWidth int // Minimum and maximum width for alignAuto; 0 for no limit.
+	maxWidth int
+	align    int
+	trim     bool
+	quote    bool
+	fill     rune
+	textCase string // "lower", "upper", or "title".
+	def      string // Default if the value is empty.
+
+	// For byte columns (utf8, utf16be, utf16le).
+	sep    string
+	hasSep bool
+	pre    string
 }
 
 type Format struct {
 	format    string         // Format string: %(..)
-	as        printAs        // How to print (list, table, json)
+	as        printAs        // How to print (list, table, json, csv, etc.)
 	re        *regexp.Regexp // Cached regexp for format.
 	cols      []column       // Columns we know about.
 	colNames  []string
 	lines     [][]string // Processed lines, to be printed.
 	autoalign []int      // Max line lengths for autoalign.
 	ntrim     int        // Number of columns with "trim"
+	typed     bool       // Use typed values for JSON.
+	emoji     bool       // Lines are emojis rather than codepoints.
+
+	order   ordering            // How to sort, group, and page results.
+	cps     []unidata.Codepoint // Codepoints to sort before adding lines.
+	raw     bool                // Raw flag for cps.
+	groups  map[int]string      // Group headers, by line number.
+	tblData []tblEntry          // Data for -as table, -as chart, and -as card.
+	tblHead []string            // Column headers for emoji tables.
+
+	tmpl *template.Template // Template from -template, instead of format.
+}
 
-	tblData []unidata.Codepoint
+// tblEntry is an entry for -as table: either a codepoint, or an emoji with all
+// its variants as columns. An emoji without codepoints is an empty column.
+type tblEntry struct {
+	cp    unidata.Codepoint
+	emoji []unidata.Emoji
 }
 
 var reFindCols = regexp.MustCompile(`%\((.*?)(?: .+?)?\)`)
@@ -76,6 +113,20 @@ const (
 	printAsJSONCompact
 	printAsTable
 	printAsTableCompact
+	printAsCSV
+	printAsCSVCompact
+	printAsTSV
+	printAsTSVCompact
+	printAsNDJSON
+	printAsNDJSONCompact
+	printAsMarkdown
+	printAsMarkdownCompact
+	printAsHTML
+	printAsHTMLCompact
+	printAsChart
+	printAsChartCompact
+	printAsCard
+	printAsCardCompact
 )
 
 func header(h string) string {
@@ -85,15 +136,32 @@ func header(h string) string {
 		return strings.ToUpper(h)
 	case "cpoint":
 		return "CPoint"
+	case "html_all":
+		return "HTML (all)"
 	default:
 		return zstring.UpperFirst(h)
 	}
 }
 
 func NewFormat(format string, as printAs, knownCols ...string) (*Format, error) {
-	f := Format{format: format, as: as}
+	f := Format{format: format, as: as, typed: typedJSON, emoji: zstring.Contains(knownCols, "emoji"),
+		order: resultOrder}
+	if err := f.checkOrder(); err != nil {
+		return nil, err
+	}
 
-	if as == printAsTable || as == printAsTableCompact {
+	if templateText != "" {
+		if as != printAsList && as != printAsListCompact {
+			return nil, errors.New("-template can't be used with -as")
+		}
+		var err error
+		f.tmpl, err = newTemplate(templateText)
+		if err != nil {
+			return nil, err
+		}
+		return &f, nil
+	}
+	if f.tbl() || f.card() {
 		// Don't need all the rest of the logic.
 		return &f, nil
 	}
@@ -137,8 +205,18 @@ func NewFormat(format string, as printAs, knownCols ...string) (*Format, error)
 	return &f, nil
 }
 
-func (f *Format) tbl() bool  { return f.as == printAsTable || f.as == printAsTableCompact }
-func (f *Format) json() bool { return f.as == printAsJSON || f.as == printAsJSONCompact }
+func (f *Format) tbl() bool {
+	return f.as == printAsTable || f.as == printAsTableCompact || f.chart()
+}
+func (f *Format) chart() bool { return f.as == printAsChart || f.as == printAsChartCompact }
+func (f *Format) card() bool  { return f.as == printAsCard || f.as == printAsCardCompact }
+func (f *Format) json() bool {
+	return f.as == printAsJSON || f.as == printAsJSONCompact || f.ndjson()
+}
+func (f *Format) ndjson() bool { return f.as == printAsNDJSON || f.as == printAsNDJSONCompact }
+func (f *Format) csv() bool {
+	return f.as == printAsCSV || f.as == printAsCSVCompact || f.as == printAsTSV || f.as == printAsTSVCompact
+}
 
 func (f *Format) processColumn(line string) error {
 	s := zstring.Fields(line[2:len(line)-1], " ") // name, flags
@@ -166,9 +244,9 @@ func (f *Format) processColumn(line string) error {
 		case flag == "t":
 			f.ntrim++
 			col.trim = true
-		case flag[0] == 'l' || flag[0] == 'r':
+		case (flag[0] == 'l' || flag[0] == 'r') && strings.Contains(flag, ":"):
 			n := strings.Split(flag, ":")
-			if len(n) != 2 {
+			if len(n) < 2 {
 				return fmt.Errorf("need width after : for %q", line)
 			}
 
@@ -178,18 +256,51 @@ func (f *Format) processColumn(line string) error {
 			}
 
 			if n[1] == "auto" {
-				// TODO: allow setting a minimum/max width:
-				//   %(col l:auto:5)     at least 5
-				//   %(col l:auto:5:10)  5-10
-				//   %(col l:auto:0:10)  at most 10
+				// %(col l:auto:5)     at least 5
+				// %(col l:auto:5:10)  5-10
+				// %(col l:auto:0:10)  at most 10
+				if len(n) > 4 {
+					return fmt.Errorf("too many widths after auto for %q", line)
+				}
 				col.width = alignAuto
+				for i, w := range n[2:] {
+					nw, err := strconv.Atoi(w)
+					if err != nil || nw < 0 {
+						return fmt.Errorf("minimum and maximum width need to be a number in %q", line)
+					}
+					if i == 0 {
+						col.minWidth = nw
+					} else {
+						col.maxWidth = nw
+					}
+				}
+				if col.maxWidth > 0 && col.minWidth > col.maxWidth {
+					return fmt.Errorf("minimum width is larger than maximum width in %q", line)
+				}
 			} else {
+				if len(n) != 2 {
+					return fmt.Errorf("need width after : for %q", line)
+				}
 				var err error
 				col.width, err = strconv.Atoi(n[1])
 				if err != nil {
 					return fmt.Errorf(`width needs to be a number or "auto" in %q`, line)
 				}
 			}
+		case flag == "lower" || flag == "upper" || flag == "title":
+			col.textCase = flag
+		case strings.HasPrefix(flag, "d:"):
+			col.def = flag[2:]
+		case strings.HasPrefix(flag, "sep:"):
+			if !isByteCol(name) {
+				return fmt.Errorf("sep: only works for utf8, utf16be, and utf16le in %q", line)
+			}
+			col.sep, col.hasSep = flag[4:], true
+		case strings.HasPrefix(flag, "pre:"):
+			if !isByteCol(name) {
+				return fmt.Errorf("pre: only works for utf8, utf16be, and utf16le in %q", line)
+			}
+			col.pre = flag[4:]
 		}
 	}
 
@@ -199,16 +310,26 @@ func (f *Format) processColumn(line string) error {
 
 // Add a new line.
 func (f *Format) Line(columns map[string]string) error {
-	if f.tbl() { // Don't need to do anything.
+	if f.tbl() || f.card() { // Don't need to do anything.
 		//f.tblData = append(f.tblData, columns)
 		return nil
 	}
+	if f.ndjson() { // Write it out right away.
+		for _, c := range f.cols {
+			columns[c.name] = f.applyFlags(c, columns[c.name])
+		}
+		return f.writeNDJSON(zli.Stdout, columns)
+	}
 
+	header := f.as == printAsList && len(f.lines) == 0
 	line := make([]string, len(f.cols))
 	for i, c := range f.cols {
 		line[i] = columns[c.name]
+		if !header {
+			line[i] = f.applyFlags(c, line[i])
+		}
 		if c.width == alignAuto {
-			if l := termtext.Width(columns[c.name]); l > f.autoalign[i] {
+			if l := termtext.Width(line[i]); l > f.autoalign[i] {
 				f.autoalign[i] = l
 			}
 		}
@@ -217,25 +338,47 @@ func (f *Format) Line(columns map[string]string) error {
 	return nil
 }
 
-// Sort by column.
-func (f *Format) Sort(col string) {
-	coli := 0
-	for i, c := range f.cols {
-		if c.name == col {
-			coli = i
-			break
+func isByteCol(name string) bool {
+	return name == "utf8" || name == "utf16be" || name == "utf16le"
+}
+
+// applyFlags applies the flags that change the value rather than the layout:
+// default values, case transforms, and the separator and prefix for bytes.
+func (f *Format) applyFlags(c column, v string) string {
+	if v == "" {
+		return c.def
+	}
+
+	switch c.textCase {
+	case "lower":
+		v = strings.ToLower(v)
+	case "upper":
+		v = strings.ToUpper(v)
+	case "title":
+		words := strings.Split(strings.ToLower(v), " ")
+		for i := range words {
+			words[i] = zstring.UpperFirst(words[i])
 		}
+		v = strings.Join(words, " ")
 	}
-	sort.Slice(f.lines, func(i, j int) bool {
-		return f.lines[i][coli] < f.lines[j][coli]
-	})
-}
 
-func (f *Format) SortNum(col string) {
-	if len(f.cols) == 0 {
-		return
+	// Typed JSON uses a list of numbers, so it would just get in the way.
+	if isByteCol(c.name) && !(f.typed && f.json()) && (c.hasSep || c.pre != "") {
+		b := strings.Split(v, " ")
+		for i := range b {
+			b[i] = c.pre + b[i]
+		}
+		sep := " "
+		if c.hasSep {
+			sep = c.sep
+		}
+		v = strings.Join(b, sep)
 	}
+	return v
+}
 
+// Sort by column.
+func (f *Format) Sort(col string) {
 	coli := 0
 	for i, c := range f.cols {
 		if c.name == col {
@@ -243,10 +386,12 @@ func (f *Format) SortNum(col string) {
 			break
 		}
 	}
-	sort.Slice(f.lines, func(i, j int) bool {
-		a, _ := strconv.Atoi(f.lines[i][coli])
-		b, _ := strconv.Atoi(f.lines[j][coli])
-		return a < b
+	lines := f.lines
+	if f.as == printAsList && len(lines) > 0 { // Keep header at the top.
+		lines = lines[1:]
+	}
+	sort.SliceStable(lines, func(i, j int) bool {
+		return lines[i][coli] < lines[j][coli]
 	})
 }
 
@@ -262,13 +407,10 @@ func (f *Format) printJSON(out io.Writer) {
 	for i, l := range f.lines {
 		m := make(map[string]string, len(f.cols))
 		for j, c := range f.cols {
-			if c.name == "wide_padding" || c.name == "tab" {
-				continue
-			}
 			m[c.name] = l[j]
 		}
 
-		enc.Encode(m)
+		enc.Encode(f.jsonObject(m))
 		out.Write(bytes.TrimSpace(buf.Bytes())) // Adds \n at end.
 		buf.Reset()
 
@@ -279,9 +421,541 @@ func (f *Format) printJSON(out io.Writer) {
 	out.Write([]byte("]\n"))
 }
 
+// writeNDJSON writes one line as a JSON object on a single line.
+func (f *Format) writeNDJSON(out io.Writer, columns map[string]string) error {
+	enc := json.NewEncoder(out)
+	enc.SetEscapeHTML(false)
+	return enc.Encode(f.jsonObject(columns))
+}
+
+// jsonObject gets the JSON object for the columns in this format.
+func (f *Format) jsonObject(columns map[string]string) map[string]interface{} {
+	m := make(map[string]interface{}, len(f.cols))
+	for _, c := range f.cols {
+		if c.name == "wide_padding" || c.name == "tab" {
+			continue
+		}
+		if f.typed {
+			m[c.name] = f.typedValue(c.name, columns[c.name])
+		} else {
+			m[c.name] = columns[c.name]
+		}
+	}
+	return m
+}
+
+// typedValue converts the string value of a column to a number or array where
+// that makes sense.
+func (f *Format) typedValue(col, v string) interface{} {
+	list := func(sep string) []string {
+		if v == "" {
+			return []string{}
+		}
+		return strings.Split(v, sep)
+	}
+	nums := func(base int, trim string) []int64 {
+		n := make([]int64, 0, 4)
+		for _, s := range strings.Fields(v) {
+			i, err := strconv.ParseInt(strings.TrimPrefix(s, trim), base, 32)
+			if err != nil {
+				return nil
+			}
+			n = append(n, i)
+		}
+		return n
+	}
+	num := func(base int, trim string) interface{} {
+		if n := nums(base, trim); len(n) == 1 {
+			return n[0]
+		}
+		return v
+	}
+
+	switch col {
+	case "dec", "assigned":
+		return num(10, "")
+	case "from", "to":
+		return num(16, "")
+	case "cpoint":
+		if f.emoji {
+			return nums(16, "U+")
+		}
+		return num(16, "U+")
+	case "utf8", "utf16be", "utf16le":
+		return nums(16, "")
+	case "props", "cldr", "cldr_full":
+		return list(", ")
+	case "html_all":
+		return list(" ")
+	case "composed-of":
+		return list(" | ")
+	}
+	return v
+}
+
+func (f *Format) printCSV(out io.Writer) {
+	w := csv.NewWriter(out)
+	if f.as == printAsTSV || f.as == printAsTSVCompact {
+		w.Comma = '\t'
+	}
+
+	cols := f.printCols()
+	if f.as == printAsCSV || f.as == printAsTSV {
+		head := make([]string, len(cols))
+		for i, c := range cols {
+			head[i] = f.cols[c].name
+		}
+		w.Write(head)
+	}
+	// encoding/csv takes care of the quoting.
+	w.WriteAll(f.printRows(cols, nil))
+}
+
+// printCols gets the indexes of all columns that should be printed for CSV,
+// markdown, and HTML; this skips columns that are only used for padding.
+func (f *Format) printCols() []int {
+	cols := make([]int, 0, len(f.cols))
+	for i, c := range f.cols {
+		if c.name != "wide_padding" && c.name != "tab" {
+			cols = append(cols, i)
+		}
+	}
+	return cols
+}
+
+// printRows gets the values of cols for every line, escaped with esc (if not
+// nil).
+func (f *Format) printRows(cols []int, esc func(string) string) [][]string {
+	rows := make([][]string, 0, len(f.lines))
+	for _, l := range f.lines {
+		row := make([]string, len(cols))
+		for i, c := range cols {
+			row[i] = l[c]
+			if esc != nil {
+				row[i] = esc(row[i])
+			}
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+func (f *Format) printMarkdown(out io.Writer) {
+	var (
+		cols    = f.printCols()
+		compact = f.as == printAsMarkdownCompact
+		esc     = strings.NewReplacer(`|`, `\|`, "\n", " ")
+		widths  = make([]int, len(cols))
+		rows    = make([][]string, 0, len(f.lines)+1)
+	)
+
+	head := make([]string, len(cols))
+	for i, c := range cols {
+		head[i] = header(f.cols[c].name)
+	}
+	rows = append(rows, head)
+	rows = append(rows, f.printRows(cols, esc.Replace)...)
+	if !compact {
+		for _, row := range rows {
+			for i, text := range row {
+				if w := termtext.Width(text); w > widths[i] {
+					widths[i] = w
+				}
+			}
+		}
+	}
+
+	b := new(strings.Builder)
+	writeRow := func(row []string) {
+		b.WriteString("|")
+		for i, text := range row {
+			if f.cols[cols[i]].align == alignRight {
+				text = zstring.AlignRight(text, widths[i])
+			} else {
+				text = zstring.AlignLeft(text, widths[i])
+			}
+			b.WriteString(" " + text + " |")
+		}
+		b.WriteString("\n")
+	}
+
+	writeRow(rows[0])
+	b.WriteString("|")
+	for i, c := range cols {
+		w := widths[i]
+		if w < 3 {
+			w = 3
+		}
+		if f.cols[c].align == alignRight {
+			b.WriteString(" " + strings.Repeat("-", w-1) + ": |")
+		} else {
+			b.WriteString(" " + strings.Repeat("-", w) + " |")
+		}
+	}
+	b.WriteString("\n")
+	for _, row := range rows[1:] {
+		writeRow(row)
+	}
+	out.Write([]byte(b.String()))
+}
+
+func (f *Format) printHTML(out io.Writer) {
+	cols := f.printCols()
+
+	b := new(strings.Builder)
+	b.WriteString("<table>\n")
+	if f.as != printAsHTMLCompact {
+		b.WriteString("<thead><tr>")
+		for _, c := range cols {
+			b.WriteString("<th>" + html.EscapeString(header(f.cols[c].name)) + "</th>")
+		}
+		b.WriteString("</tr></thead>\n")
+	}
+	b.WriteString("<tbody>\n")
+	for _, row := range f.printRows(cols, html.EscapeString) {
+		b.WriteString("<tr>")
+		for _, text := range row {
+			b.WriteString("<td>" + text + "</td>")
+		}
+		b.WriteString("</tr>\n")
+	}
+	b.WriteString("</tbody>\n</table>\n")
+	out.Write([]byte(b.String()))
+}
+
+// printChart prints the same grid as printTbl, but as a self-contained HTML
+// page with an SVG chart, similar to the Unicode code charts.
+func (f *Format) printChart(out io.Writer) {
+	if len(f.tblData) == 0 {
+		return
+	}
+	sort.Slice(f.tblData, func(i, j int) bool {
+		return f.tblData[i].cp.Codepoint < f.tblData[j].cp.Codepoint
+	})
+
+	var (
+		tblMap = make(map[rune]unidata.Codepoint)
+		start  = f.tblData[0].cp.Codepoint
+		end    = f.tblData[len(f.tblData)-1].cp.Codepoint
+		title  = fmt.Sprintf("U+%04X – U+%04X", start, end)
+	)
+	for _, c := range f.tblData {
+		tblMap[c.cp.Codepoint] = c.cp
+	}
+	if bl := f.tblData[0].cp.Block(); bl == f.tblData[len(f.tblData)-1].cp.Block() {
+		title = bl.String()
+	}
+	start -= start % 16
+
+	// Rows to print; a negative value is a gap of rows without anything in the
+	// selection.
+	var rows []rune
+	for r := start; r <= end; r += 16 {
+		has := false
+		for i := r; i < r+16; i++ {
+			if _, ok := tblMap[i]; ok {
+				has = true
+				break
+			}
+		}
+		if has {
+			rows = append(rows, r)
+		} else if len(rows) == 0 || rows[len(rows)-1] >= 0 {
+			rows = append(rows, -1)
+		}
+	}
+
+	const (
+		cellW = 44
+		cellH = 52
+		headW = 80
+		headH = 24
+	)
+	cellHeight := cellH
+	if f.as == printAsChartCompact {
+		cellHeight = cellH - 14
+	}
+
+	b := new(strings.Builder)
+	fmt.Fprintf(b, `<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>%[1]s</title>
+<style>
+	body       { font-family: sans-serif; margin: 2em; }
+	svg text   { text-anchor: middle; dominant-baseline: central; }
+	.head      { font: 12px monospace; fill: #555; }
+	.char      { font-size: 22px; }
+	.cp        { font: 9px monospace; fill: #555; }
+	rect       { fill: #fff; stroke: #999; }
+	.unassigned rect { fill: #ccc; }
+	.other .char     { fill: #bbb; }
+	g:hover rect     { fill: #ffd; }
+</style>
+</head>
+<body>
+<h1>%[1]s</h1>
+`, html.EscapeString(title))
+
+	fmt.Fprintf(b, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d">`+"\n",
+		headW+16*cellW+1, headH+len(rows)*cellHeight+1)
+	for i := 0; i < 16; i++ {
+		fmt.Fprintf(b, `<text class="head" x="%d" y="%d">%X</text>`+"\n",
+			headW+i*cellW+cellW/2, headH/2, i)
+	}
+	for y, r := range rows {
+		top := headH + y*cellHeight
+		if r < 0 {
+			fmt.Fprintf(b, `<text class="head" x="%d" y="%d">…</text>`+"\n", headW/2, top+cellHeight/2)
+			continue
+		}
+		fmt.Fprintf(b, `<text class="head" x="%d" y="%d">U+%03Xx</text>`+"\n", headW/2, top+cellHeight/2, r/16)
+
+		for i := r; i < r+16; i++ {
+			var (
+				x                 = headW + int(i-r)*cellW
+				class, char       string
+				name              = "<unassigned>"
+				info, inSel       = tblMap[i]
+				other, isAssigned = unidata.Find(i) // Not Codepoints, as CJK, Hangul, etc. are ranges.
+			)
+			isAssigned = isAssigned && other.Category() != unidata.CatUnassigned
+			switch {
+			case !isAssigned:
+				class = "unassigned"
+			case !inSel:
+				class, info = "other", other
+			}
+			if isAssigned {
+				name, char = info.Name(), info.Display()
+			}
+
+			if class != "" {
+				class = ` class="` + class + `"`
+			}
+			fmt.Fprintf(b, `<g%s><title>U+%04X %s</title>`, class, i, html.EscapeString(name))
+			fmt.Fprintf(b, `<rect x="%d" y="%d" width="%d" height="%d"/>`, x, top, cellW, cellHeight)
+			if char != "" {
+				fmt.Fprintf(b, `<text class="char" x="%d" y="%d">%s</text>`, x+cellW/2, top+19, html.EscapeString(char))
+			}
+			if f.as != printAsChartCompact {
+				fmt.Fprintf(b, `<text class="cp" x="%d" y="%d">%04X</text>`, x+cellW/2, top+cellH-9, i)
+			}
+			b.WriteString("</g>\n")
+		}
+	}
+	b.WriteString("</svg>\n</body>\n</html>\n")
+	out.Write([]byte(b.String()))
+}
+
+// printCard prints every known attribute for all codepoints or emojis, one per
+// line and grouped in sections.
+func (f *Format) printCard(out io.Writer) {
+	type field struct{ label, value string }
+	type section struct {
+		title  string
+		fields []field
+	}
+
+	var (
+		b       = new(strings.Builder)
+		compact = f.as == printAsCardCompact
+	)
+	write := func(title string, sections ...section) {
+		if b.Len() > 0 {
+			b.WriteString("\n")
+		}
+		b.WriteString(title + "\n")
+		for _, s := range sections {
+			var n int
+			for _, fl := range s.fields {
+				if fl.value != "" {
+					n++
+				}
+			}
+			if n == 0 {
+				continue
+			}
+
+			if !compact {
+				b.WriteString("\n")
+			}
+			b.WriteString("  " + s.title + "\n")
+			for _, fl := range s.fields {
+				if fl.value == "" {
+					continue
+				}
+				if fl.label == "" {
+					b.WriteString("    " + fl.value + "\n")
+				} else {
+					b.WriteString("    " + zstring.AlignLeft(fl.label, 12) + fl.value + "\n")
+				}
+			}
+		}
+	}
+
+	for _, e := range f.tblData {
+		if e.emoji != nil {
+			em := e.emoji[0]
+			cps := make([]field, 0, len(em.Codepoints))
+			for _, c := range em.String() {
+				cp, _ := unidata.Find(c)
+				cps = append(cps, field{fmt.Sprintf("U+%04X", c), cp.Name()})
+			}
+			write(em.String()+"  "+em.Name,
+				section{"Names", []field{
+					{"Name", em.Name},
+					{"CLDR", strings.Join(em.CLDR, ", ")},
+				}},
+				section{"Classification", []field{
+					{"Group", em.Group().String()},
+					{"Subgroup", em.Subgroup().String()},
+				}},
+				section{"Codepoints", cps})
+			continue
+		}
+
+		var (
+			c     = e.cp
+			cat   = unidata.Categories[c.Category()]
+			block = unidata.Blocks[c.Block()]
+			props = make([]field, 0, 2)
+		)
+		for _, p := range c.Properties() {
+			props = append(props, field{"", p.String()})
+		}
+		sort.Slice(props, func(i, j int) bool { return props[i].value < props[j].value })
+
+		write(c.Display()+"  "+c.FormatCodepoint()+"  "+c.Name(),
+			section{"Encodings", []field{
+				{"Decimal", c.Format(10)},
+				{"Hex", c.Format(16)},
+				{"Octal", c.Format(8)},
+				{"Binary", c.Format(2)},
+				{"UTF-8", fmt.Sprintf("% x", c.UTF8())},
+				{"UTF-16 BE", fmt.Sprintf("% x", c.UTF16(true))},
+				{"UTF-16 LE", fmt.Sprintf("% x", c.UTF16(false))},
+				{"JSON", c.JSON()},
+				{"XML", c.XML()},
+			}},
+			section{"Names", []field{
+				{"Name", c.Name()},
+				{"Aliases", strings.Join(c.Aliases(), ", ")},
+			}},
+			section{"Classification", []field{
+				{"Category", cat.ShortName + " (" + cat.Name + ")"},
+				{"Script", c.Script().String()},
+				{"Block", fmt.Sprintf("%s (U+%04X..U+%04X)", block.Name, block.Range[0], block.Range[1])},
+				{"Plane", c.Plane().String()},
+				{"Width", c.Width().String()},
+				{"Age", c.Age()},
+			}},
+			section{"Properties", props},
+			section{"Input", []field{
+				{"HTML", strings.Join(c.HTMLAll(), " ")},
+				{"Keysym", c.KeySym()},
+				{"Digraph", c.Digraph()},
+			}})
+	}
+	out.Write([]byte(b.String()))
+}
+
+// printEmojiTbl prints emojis as a grid, grouped by subgroup. If there's only
+// one column then all emojis in a subgroup are on the same row (wrapped at 16),
+// otherwise every emoji gets its own row with a column for every variant.
+func (f *Format) printEmojiTbl(out io.Writer) {
+	var (
+		b       = new(strings.Builder)
+		ncol    = len(f.tblData[0].emoji)
+		cellW   = 4
+		headW   = 0
+		compact = f.as == printAsTableCompact
+	)
+	for _, h := range f.tblHead {
+		if w := termtext.Width(h) + 1; w > cellW {
+			cellW = w
+		}
+	}
+	for _, e := range f.tblData {
+		if w := termtext.Width(e.emoji[0].Subgroup().String()); w > headW {
+			headW = w
+		}
+	}
+	headW += 3
+
+	cell := func(e unidata.Emoji) string {
+		if len(e.Codepoints) == 0 {
+			return strings.Repeat(" ", cellW)
+		}
+		return e.String() + strings.Repeat(" ", cellW-2) // Emojis are always wide.
+	}
+	row := func(head string, cells []unidata.Emoji) {
+		l := zstring.AlignLeft("   "+head, headW) + " │ "
+		for _, c := range cells {
+			l += cell(c)
+		}
+		b.WriteString(strings.TrimRight(l, " ") + "\n")
+		if !compact {
+			b.WriteString(strings.Repeat(" ", headW) + " │\n")
+		}
+	}
+
+	if ncol > 1 && len(f.tblHead) > 0 {
+		l := strings.Repeat(" ", headW) + "   "
+		for _, h := range f.tblHead {
+			l += zstring.AlignLeft(h, cellW)
+		}
+		b.WriteString(strings.TrimRight(l, " ") + "\n")
+	}
+
+	var (
+		group    = unidata.EmojiGroup(255)
+		subgroup = unidata.EmojiSubgroup(65535)
+		pending  []unidata.Emoji
+		head     string
+	)
+	flush := func() {
+		for i := 0; i < len(pending); i += 16 {
+			end := i + 16
+			if end > len(pending) {
+				end = len(pending)
+			}
+			row(head, pending[i:end])
+			head = ""
+		}
+		pending = pending[:0]
+	}
+	for _, e := range f.tblData {
+		base := e.emoji[0]
+		if base.Subgroup() != subgroup {
+			flush()
+			if base.Group() != group {
+				group = base.Group()
+				b.WriteString(group.String() + "\n")
+			}
+			subgroup, head = base.Subgroup(), base.Subgroup().String()
+		}
+
+		if ncol == 1 {
+			pending = append(pending, base)
+			continue
+		}
+		row(head, e.emoji)
+		head = ""
+	}
+	flush()
+	out.Write([]byte(b.String()))
+}
+
 func (f *Format) printTbl(out io.Writer) {
+	if len(f.tblData) > 0 && f.tblData[0].emoji != nil {
+		f.printEmojiTbl(out)
+		return
+	}
+
 	sort.Slice(f.tblData, func(i, j int) bool {
-		return f.tblData[i].Codepoint < f.tblData[j].Codepoint
+		return f.tblData[i].cp.Codepoint < f.tblData[j].cp.Codepoint
 	})
 
 	var (
@@ -289,7 +963,8 @@ func (f *Format) printTbl(out io.Writer) {
 		tblMap = make(map[rune]string)
 		head   = 4
 	)
-	for _, c := range f.tblData {
+	for _, e := range f.tblData {
+		c := e.cp
 		if w := c.Width(); w == unidata.WidthFullWidth || w == unidata.WidthWide || w == unidata.WidthAmbiguous {
 			wide = true
 		}
@@ -308,7 +983,7 @@ func (f *Format) printTbl(out io.Writer) {
 		fmt.Println(h + "   ┌" + strings.Repeat("─", 48))
 	}
 
-	start, end := f.tblData[0].Codepoint, f.tblData[len(f.tblData)-1].Codepoint
+	start, end := f.tblData[0].cp.Codepoint, f.tblData[len(f.tblData)-1].cp.Codepoint
 	start -= start % 16 /// Make sure we start at column 0
 
 	var (
@@ -361,16 +1036,53 @@ func (f *Format) printTbl(out io.Writer) {
 }
 
 func (f *Format) Print(out io.Writer) {
+	if f.cps != nil {
+		f.orderCodepoints()
+	}
+	if f.ndjson() { // Already written in Line().
+		return
+	}
 	if f.json() {
 		f.printJSON(out)
 		return
 	}
+	if f.csv() {
+		f.printCSV(out)
+		return
+	}
+	if f.as == printAsMarkdown || f.as == printAsMarkdownCompact {
+		f.printMarkdown(out)
+		return
+	}
+	if f.as == printAsHTML || f.as == printAsHTMLCompact {
+		f.printHTML(out)
+		return
+	}
+	if f.chart() {
+		f.printChart(out)
+		return
+	}
+	if f.card() {
+		f.printCard(out)
+		return
+	}
 	if f.tbl() {
 		f.printTbl(out)
 		return
 	}
+	if f.tmpl != nil {
+		f.printTemplate(out)
+		return
+	}
 
 	for lineno, l := range f.lines {
+		if h, ok := f.groups[lineno]; ok {
+			if lineno > 0 && (lineno > 1 || f.as != printAsList) && f.as != printAsListCompact {
+				out.Write([]byte("\n"))
+			}
+			out.Write([]byte(h + "\n"))
+		}
+
 		line := f.format
 
 		// TODO: we can probably make this a bit faster by getting the text
@@ -439,6 +1151,15 @@ func (f *Format) fmtPlaceholder(i, lineno int, text string, applyTrim int) strin
 	w := c.width
 	if w == alignAuto {
 		w = f.autoalign[i]
+		if w < c.minWidth {
+			w = c.minWidth
+		}
+		if c.maxWidth > 0 && w > c.maxWidth {
+			w = c.maxWidth
+			if utf8.RuneCountInString(text) > w {
+				text = zstring.ElideLeft(text, w-1)
+			}
+		}
 	}
 	switch c.align {
 	case alignLeft:
@@ -466,12 +1187,12 @@ func (f *Format) String() string {
 }
 
 var knownColumns = []string{"char", "wide_padding", "cpoint", "dec", "hex",
-	"oct", "bin", "utf8", "utf16be", "utf16le", "html", "xml", "json", "keysym",
-	"digraph", "name", "cat", "block", "plane", "width", "props", "script"}
+	"oct", "bin", "utf8", "utf16be", "utf16le", "html", "html_all", "xml", "json", "keysym",
+	"digraph", "ascii", "simplified", "traditional", "variants", "precis", "name", "cat", "block", "plane", "width", "props", "script", "age"}
 
 func (f *Format) toLine(info unidata.Codepoint, raw bool) map[string]string {
-	if f.tbl() {
-		f.tblData = append(f.tblData, info)
+	if f.tbl() || f.card() {
+		f.tblData = append(f.tblData, tblEntry{cp: info})
 		return nil
 	}
 
@@ -488,10 +1209,16 @@ func (f *Format) toLine(info unidata.Codepoint, raw bool) map[string]string {
 			"utf16be":      fmt.Sprintf("% x", info.UTF16(true)),
 			"utf16le":      fmt.Sprintf("% x", info.UTF16(false)),
 			"html":         info.HTML(),
+			"html_all":     strings.Join(info.HTMLAll(), " "),
 			"xml":          info.XML(),
 			"json":         info.JSON(),
 			"keysym":       info.KeySym(),
 			"digraph":      info.Digraph(),
+			"ascii":        asciiColumn(info.Codepoint),
+			"simplified":   info.Simplified(),
+			"traditional":  info.Traditional(),
+			"variants":     info.Variants(),
+			"precis":       precisProperty(info.Codepoint),
 			"name":         info.Name(),
 			"cat":          info.Category().String(),
 			"block":        info.Block().String(),
@@ -499,6 +1226,7 @@ func (f *Format) toLine(info unidata.Codepoint, raw bool) map[string]string {
 			"width":        info.Width().String(),
 			"props":        info.Properties().String(),
 			"script":       info.Script().String(),
+			"age":          info.Age(),
 		}
 	}
 
@@ -543,6 +1271,9 @@ func (f *Format) toLine(info unidata.Codepoint, raw bool) map[string]string {
 	if zstring.Contains(f.colNames, "html") {
 		cols["html"] = info.HTML()
 	}
+	if zstring.Contains(f.colNames, "html_all") {
+		cols["html_all"] = strings.Join(info.HTMLAll(), " ")
+	}
 	if zstring.Contains(f.colNames, "xml") {
 		cols["xml"] = info.XML()
 	}
@@ -555,6 +1286,21 @@ func (f *Format) toLine(info unidata.Codepoint, raw bool) map[string]string {
 	if zstring.Contains(f.colNames, "digraph") {
 		cols["digraph"] = info.Digraph()
 	}
+	if zstring.Contains(f.colNames, "ascii") {
+		cols["ascii"] = asciiColumn(info.Codepoint)
+	}
+	if zstring.Contains(f.colNames, "simplified") {
+		cols["simplified"] = info.Simplified()
+	}
+	if zstring.Contains(f.colNames, "traditional") {
+		cols["traditional"] = info.Traditional()
+	}
+	if zstring.Contains(f.colNames, "variants") {
+		cols["variants"] = info.Variants()
+	}
+	if zstring.Contains(f.colNames, "precis") {
+		cols["precis"] = precisProperty(info.Codepoint)
+	}
 	if zstring.Contains(f.colNames, "name") {
 		cols["name"] = info.Name()
 	}
@@ -567,6 +1313,9 @@ func (f *Format) toLine(info unidata.Codepoint, raw bool) map[string]string {
 	if zstring.Contains(f.colNames, "script") {
 		cols["script"] = info.Script().String()
 	}
+	if zstring.Contains(f.colNames, "age") {
+		cols["age"] = info.Age()
+	}
 	if zstring.Contains(f.colNames, "plane") {
 		cols["plane"] = info.Plane().String()
 	}
@@ -4,13 +4,14 @@ go 1.17
 
 require (
 	github.com/mattn/go-runewidth v0.0.13
+	github.com/rivo/uniseg v0.2.0
+	golang.org/x/text v0.13.0
 	zgo.at/termtext v1.1.0
 	zgo.at/zli v0.0.0-20220625213957-6e39ac414c92
 	zgo.at/zstd v0.0.0-20220413140508-6078fed48e39
 )
 
 require (
-	github.com/rivo/uniseg v0.2.0 // indirect
-	golang.org/x/sys v0.0.0-20210615035016-665e8c7367d1 // indirect
+	golang.org/x/sys v0.10.0 // indirect
 	golang.org/x/term v0.0.0-20220411215600-e5f449aeb171 // indirect
 )
@@ -0,0 +1,212 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/text/unicode/norm"
+	"zgo.at/uni/v2/unidata"
+	"zgo.at/zli"
+)
+
+// errIdentInvalid is returned if any of the identifiers are invalid; this
+// doesn't print an error, but exits with 1.
+var errIdentInvalid = fmt.Errorf("ident: invalid identifier: %w", errSilent)
+
+var identProfiles = []string{"uax31", "go", "rust", "python", "javascript", "restricted"}
+
+var (
+	idStart     = newRangeSet(unidata.DerivedProperties[unidata.DerivedIDStart].Ranges...)
+	idContinue  = newRangeSet(unidata.DerivedProperties[unidata.DerivedIDContinue].Ranges...)
+	xidStart    = newRangeSet(unidata.DerivedProperties[unidata.DerivedXIDStart].Ranges...)
+	xidContinue = newRangeSet(unidata.DerivedProperties[unidata.DerivedXIDContinue].Ranges...)
+)
+
+type (
+	identResult struct {
+		Input    string           `json:"input"`
+		Valid    bool             `json:"valid"`
+		Invalid  []identCodepoint `json:"invalid"`
+		Errors   []string         `json:"errors"` // For the entire identifier, rather than a codepoint.
+		Warnings []string         `json:"warnings"`
+	}
+	identCodepoint struct {
+		Pos    int    `json:"pos"` // In codepoints, starting at 1.
+		Cpoint string `json:"cpoint"`
+		Name   string `json:"name"`
+		Reason string `json:"reason"`
+	}
+)
+
+func identCmd(args []string, profile string, as printAs) error {
+	if profile == "" {
+		profile = "uax31"
+	}
+	p, err := match(profile, identProfiles...)
+	if err != nil {
+		return fmt.Errorf("ident: unknown profile %q; one of: %s", profile, strings.Join(identProfiles, ", "))
+	}
+
+	results := make([]identResult, 0, len(args))
+	for _, a := range args {
+		results = append(results, ident(a, p))
+	}
+	if len(results) == 0 {
+		return errors.New("ident: need an identifier")
+	}
+
+	switch as {
+	case printAsJSON, printAsJSONCompact:
+		writeJSON(zli.Stdout, results, as == printAsJSONCompact)
+	case printAsListCompact:
+		for _, r := range results {
+			if r.Valid {
+				fmt.Fprintln(zli.Stdout, r.Input)
+			}
+		}
+	case printAsList:
+		for i, r := range results {
+			if i > 0 {
+				fmt.Fprintln(zli.Stdout)
+			}
+			fmt.Fprintf(zli.Stdout, "input:    %s\nvalid:    %s\n", r.Input, map[bool]string{true: "yes", false: "no"}[r.Valid])
+			for _, c := range r.Invalid {
+				fmt.Fprintf(zli.Stdout, "error:    %s %s at position %d: %s\n", c.Cpoint, c.Name, c.Pos, c.Reason)
+			}
+			for _, e := range r.Errors {
+				fmt.Fprintf(zli.Stdout, "error:    %s\n", e)
+			}
+			for _, w := range r.Warnings {
+				fmt.Fprintf(zli.Stdout, "warning:  %s\n", w)
+			}
+		}
+	default:
+		return errors.New("ident only supports -as list and json")
+	}
+
+	for _, r := range results {
+		if !r.Valid {
+			return errIdentInvalid
+		}
+	}
+	return nil
+}
+
+// ident checks if s is a valid identifier in the profile.
+//
+// This only checks the characters; reserved words such as "func" or "class"
+// aren't identifiers in most languages either, but that's not checked.
+func ident(s, profile string) identResult {
+	res := identResult{Input: s, Invalid: []identCodepoint{}, Errors: []string{}, Warnings: []string{}}
+	if s == "" {
+		res.Errors = append(res.Errors, "empty string")
+		return res
+	}
+
+	runes := []rune(s)
+	for i, r := range runes {
+		if reason := identRune(r, i == 0, profile); reason != "" {
+			info, _ := unidata.Find(r)
+			res.Invalid = append(res.Invalid, identCodepoint{
+				Pos:    i + 1,
+				Cpoint: info.FormatCodepoint(),
+				Name:   info.Name(),
+				Reason: reason,
+			})
+		}
+	}
+
+	switch profile {
+	case "rust":
+		if s == "_" {
+			res.Errors = append(res.Errors, "_ on its own is not an identifier")
+		}
+		// Rust normalizes identifiers, so "é" with a combining accent is the
+		// same identifier as the precomposed "é".
+		if n := norm.NFC.String(s); n != s {
+			res.Warnings = append(res.Warnings, fmt.Sprintf("same identifier as %q (NFC)", n))
+		}
+	case "python":
+		if n := norm.NFKC.String(s); n != s {
+			res.Warnings = append(res.Warnings, fmt.Sprintf("same identifier as %q (NFKC)", n))
+		}
+	case "restricted":
+		if scripts, first := mixedScripts(runes); scripts != nil {
+			names := make([]string, 0, len(scripts))
+			for _, s := range scripts {
+				names = append(names, s.String())
+			}
+			res.Errors = append(res.Errors, fmt.Sprintf("mixes %s from position %d, which isn't allowed in the Highly Restrictive level",
+				strings.Join(names, " and "), first+1))
+		}
+		fallthrough
+	default:
+		if !norm.NFC.IsNormalString(s) {
+			res.Warnings = append(res.Warnings, fmt.Sprintf("not in NFC; the NFC form is %q", norm.NFC.String(s)))
+		}
+	}
+
+	res.Valid = len(res.Invalid) == 0 && len(res.Errors) == 0
+	return res
+}
+
+// identRune checks if r is allowed in an identifier, returning why it's not
+// allowed, or an empty string if it is.
+func identRune(r rune, first bool, profile string) string {
+	switch profile {
+	// Go: letter { letter | unicode_digit }, where letter is a Lu, Ll, Lt,
+	// Lm, Lo, or "_", and unicode_digit is Nd.
+	case "go":
+		info, _ := unidata.Find(r)
+		switch cat := info.Category(); {
+		case r == '_' || cat == unidata.CatUppercaseLetter || cat == unidata.CatLowercaseLetter ||
+			cat == unidata.CatTitlecaseLetter || cat == unidata.CatModifierLetter || cat == unidata.CatOtherLetter:
+			return ""
+		case first:
+			return fmt.Sprintf("not a letter or _ (category %s)", unidata.Categories[cat].ShortName)
+		case cat == unidata.CatDecimalNumber:
+			return ""
+		default:
+			return fmt.Sprintf("not a letter, decimal digit, or _ (category %s)", unidata.Categories[cat].ShortName)
+		}
+
+	// JavaScript: ID_Start, "$", or "_", followed by ID_Continue, "$", ZWNJ,
+	// or ZWJ.
+	case "javascript":
+		switch {
+		case first && !idStart.contains(r) && r != '$' && r != '_':
+			return "not ID_Start, $, or _"
+		case !first && !idContinue.contains(r) && r != '$' && r != 0x200c && r != 0x200d:
+			return "not ID_Continue, $, ZWNJ, or ZWJ"
+		}
+		return ""
+
+	// Rust and Python: XID_Start or "_", followed by XID_Continue.
+	case "rust", "python":
+		switch {
+		case first && !xidStart.contains(r) && r != '_':
+			return "not XID_Start or _"
+		case !first && !xidContinue.contains(r):
+			return "not XID_Continue"
+		}
+		return ""
+
+	// UTS #39 General Security Profile: only codepoints with the "Allowed"
+	// Identifier_Status. This includes a few that aren't XID_Continue, such
+	// as "-", so the UAX #31 checks below still apply.
+	case "restricted":
+		if info := (unidata.Codepoint{Codepoint: r}); info.IdentifierStatus() != "Allowed" {
+			return fmt.Sprintf("Identifier_Status is Restricted (Identifier_Type %s)", strings.Join(info.IdentifierType(), ", "))
+		}
+	}
+
+	// UAX #31 default identifiers: XID_Start followed by XID_Continue.
+	switch {
+	case first && !xidStart.contains(r):
+		return "not XID_Start"
+	case !first && !xidContinue.contains(r):
+		return "not XID_Continue"
+	}
+	return ""
+}
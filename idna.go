@@ -0,0 +1,516 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"golang.org/x/text/unicode/norm"
+	"zgo.at/termtext"
+	"zgo.at/uni/v2/unidata"
+	"zgo.at/zli"
+)
+
+// errIDNAInvalid is returned if any of the domains are invalid; this doesn't
+// print an error, but exits with 1.
+var errIDNAInvalid = fmt.Errorf("idna: invalid domain name: %w", errSilent)
+
+type (
+	idnaResult struct {
+		Input   string      `json:"input"`
+		Unicode string      `json:"unicode"`
+		ASCII   string      `json:"ascii"`
+		Mapped  []idnaMap   `json:"mapped"`
+		Labels  []idnaLabel `json:"labels"`
+		Errors  []string    `json:"errors"` // For the entire domain, rather than a label.
+	}
+	idnaMap struct {
+		Status string `json:"status"`
+		From   string `json:"from"`
+		To     string `json:"to"`
+	}
+	idnaLabel struct {
+		Unicode  string   `json:"unicode"`
+		ASCII    string   `json:"ascii"`
+		Errors   []string `json:"errors"`
+		Warnings []string `json:"warnings"`
+	}
+)
+
+func (r idnaResult) valid() bool {
+	if len(r.Errors) > 0 {
+		return false
+	}
+	for _, l := range r.Labels {
+		if len(l.Errors) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func idnaCmd(args []string, as printAs) error {
+	var results []idnaResult
+	for _, a := range args {
+		for _, d := range strings.Fields(a) {
+			results = append(results, idna(d))
+		}
+	}
+	if len(results) == 0 {
+		return errors.New("idna: need a domain name")
+	}
+
+	switch as {
+	case printAsJSON, printAsJSONCompact:
+		writeJSON(zli.Stdout, results, as == printAsJSONCompact)
+	case printAsListCompact:
+		for _, r := range results {
+			fmt.Fprintf(zli.Stdout, "%s %s\n", r.Unicode, r.ASCII)
+		}
+	case printAsList:
+		for i, r := range results {
+			if i > 0 {
+				fmt.Fprintln(zli.Stdout)
+			}
+			fmt.Fprintf(zli.Stdout, "input:    %s\nunicode:  %s\nascii:    %s\n", r.Input, r.Unicode, r.ASCII)
+			for _, m := range r.Mapped {
+				to := describeCodepoints(m.To)
+				if to == "" {
+					to = "(removed)"
+				}
+				fmt.Fprintf(zli.Stdout, "%-10s%s → %s\n", m.Status+":", describeCodepoints(m.From), to)
+			}
+
+			w := 0
+			for _, l := range r.Labels {
+				if lw := termtext.Width(l.Unicode); lw > w {
+					w = lw
+				}
+			}
+			fmt.Fprintln(zli.Stdout, "labels:")
+			for _, l := range r.Labels {
+				fmt.Fprintf(zli.Stdout, "  %s  %s\n", termtext.AlignLeft(l.Unicode, w), l.ASCII)
+				for _, e := range l.Errors {
+					fmt.Fprintf(zli.Stdout, "    error: %s\n", e)
+				}
+				for _, e := range l.Warnings {
+					fmt.Fprintf(zli.Stdout, "    warning: %s\n", e)
+				}
+			}
+			for _, e := range r.Errors {
+				fmt.Fprintf(zli.Stdout, "error: %s\n", e)
+			}
+		}
+	default:
+		return errors.New("idna only supports -as list and json")
+	}
+
+	for _, r := range results {
+		if !r.valid() {
+			return errIDNAInvalid
+		}
+	}
+	return nil
+}
+
+// idna processes the domain according to UTS #46, with nontransitional
+// processing, UseSTD3ASCIIRules, CheckHyphens, CheckBidi, CheckJoiners, and
+// VerifyDnsLength.
+func idna(domain string) idnaResult {
+	res := idnaResult{Input: domain, Mapped: []idnaMap{}, Labels: []idnaLabel{}, Errors: []string{}}
+
+	// Map; every codepoint is listed only once in res.Mapped.
+	var (
+		b    = new(strings.Builder)
+		seen = make(map[rune]bool)
+	)
+	for _, r := range domain {
+		st, m := unidata.Codepoint{Codepoint: r}.IDNA()
+		switch st {
+		case unidata.IDNAIgnored:
+			if !seen[r] {
+				res.Mapped = append(res.Mapped, idnaMap{Status: "ignored", From: string(r)})
+			}
+			seen[r] = true
+		case unidata.IDNAMapped:
+			if !seen[r] {
+				res.Mapped = append(res.Mapped, idnaMap{Status: "mapped", From: string(r), To: m})
+			}
+			seen[r] = true
+			b.WriteString(m)
+		default:
+			// Disallowed codepoints are reported when validating the label.
+			b.WriteRune(r)
+		}
+	}
+
+	// Normalize and break into labels.
+	labels := strings.Split(norm.NFC.String(b.String()), ".")
+
+	// The bidi rules are only checked in domain names that have at least one
+	// right-to-left label.
+	bidiDomain := false
+	for _, l := range labels {
+		l = strings.ToLower(l)
+		if strings.HasPrefix(l, "xn--") {
+			d, err := punyDecode(l[4:])
+			if err != nil {
+				continue
+			}
+			l = d
+		}
+		for _, r := range l {
+			if c := (unidata.Codepoint{Codepoint: r}).BidiClass(); c == "R" || c == "AL" || c == "AN" {
+				bidiDomain = true
+			}
+		}
+	}
+
+	var uni, ascii []string
+	for _, l := range labels {
+		label := idnaLabel{Unicode: l, ASCII: l, Errors: []string{}, Warnings: []string{}}
+		if strings.HasPrefix(strings.ToLower(l), "xn--") {
+			d, err := punyDecode(l[4:])
+			if err == nil && (d == "" || isASCII(d)) {
+				err = errors.New("no non-ASCII characters")
+			}
+			if err != nil {
+				label.Errors = append(label.Errors, "invalid punycode: "+err.Error())
+				res.Labels = append(res.Labels, label)
+				uni, ascii = append(uni, label.Unicode), append(ascii, label.ASCII)
+				continue
+			}
+
+			label.Unicode, label.ASCII = d, strings.ToLower(l)
+			if !norm.NFC.IsNormalString(d) {
+				label.Errors = append(label.Errors, "not in NFC")
+			}
+		} else if l != "" && !isASCII(l) {
+			label.ASCII = "xn--" + punyEncode(l)
+		}
+
+		label.Errors = append(label.Errors, idnaValidate(label.Unicode, bidiDomain)...)
+		label.Warnings = append(label.Warnings, idnaWarnings(label.Unicode)...)
+		switch n := len(label.ASCII); {
+		case n == 0:
+			label.Errors = append(label.Errors, "empty label")
+		case n > 63:
+			label.Errors = append(label.Errors, fmt.Sprintf("label is %d bytes in ASCII; the maximum is 63", n))
+		}
+
+		res.Labels = append(res.Labels, label)
+		uni, ascii = append(uni, label.Unicode), append(ascii, label.ASCII)
+	}
+
+	res.Unicode, res.ASCII = strings.Join(uni, "."), strings.Join(ascii, ".")
+	if n := len(res.ASCII); n > 253 {
+		res.Errors = append(res.Errors, fmt.Sprintf("domain is %d bytes in ASCII; the maximum is 253", n))
+	}
+	return res
+}
+
+// idnaValidate checks if the label is valid.
+func idnaValidate(label string, bidiDomain bool) []string {
+	var errs []string
+	if len(label) >= 4 && label[2:4] == "--" {
+		errs = append(errs, `"--" in the third and fourth position`)
+	}
+	if strings.HasPrefix(label, "-") || strings.HasSuffix(label, "-") {
+		errs = append(errs, "starts or ends with a hyphen")
+	}
+
+	runes := []rune(label)
+	for i, r := range runes {
+		info, _ := unidata.Find(r)
+		if cat := info.Category(); i == 0 && (cat == unidata.CatNonspacingMark || cat == unidata.CatSpacingMark || cat == unidata.CatEnclosingMark) {
+			errs = append(errs, "starts with combining mark "+describeCodepoints(string(r)))
+		}
+
+		switch st, _ := info.IDNA(); st {
+		case unidata.IDNAValid, unidata.IDNADeviation:
+		case unidata.IDNADisallowedSTD3Valid, unidata.IDNADisallowedSTD3Mapped:
+			errs = append(errs, describeCodepoints(string(r))+" is not allowed in host names (STD3)")
+		case unidata.IDNAMapped, unidata.IDNAIgnored:
+			errs = append(errs, describeCodepoints(string(r))+" is not allowed in punycode ("+st.String()+")")
+		default:
+			errs = append(errs, describeCodepoints(string(r))+" is not allowed")
+		}
+
+		if r == 0x200c || r == 0x200d {
+			if !contextJ(runes, i) {
+				errs = append(errs, describeCodepoints(string(r))+" is not allowed here (CONTEXTJ)")
+			}
+		}
+	}
+
+	if bidiDomain {
+		if e := bidiRule(runes); e != "" {
+			errs = append(errs, "bidi rule: "+e)
+		}
+	}
+	return errs
+}
+
+// contextJ checks the CONTEXTJ rules for ZWNJ and ZWJ from RFC 5892 appendix
+// A.1 and A.2.
+func contextJ(runes []rune, i int) bool {
+	if i > 0 && (unidata.Codepoint{Codepoint: runes[i-1]}).CombiningClass() == 9 { // Virama
+		return true
+	}
+	if runes[i] == 0x200d {
+		return false
+	}
+
+	// ZWNJ: (Joining_Type:{L,D})(Joining_Type:T)* ZWNJ (Joining_Type:T)*(Joining_Type:{R,D})
+	before, after := false, false
+	for j := i - 1; j >= 0; j-- {
+		jt := (unidata.Codepoint{Codepoint: runes[j]}).JoiningType()
+		if jt == "T" {
+			continue
+		}
+		before = jt == "L" || jt == "D"
+		break
+	}
+	for j := i + 1; j < len(runes); j++ {
+		jt := (unidata.Codepoint{Codepoint: runes[j]}).JoiningType()
+		if jt == "T" {
+			continue
+		}
+		after = jt == "R" || jt == "D"
+		break
+	}
+	return before && after
+}
+
+// bidiRule checks the label or string against the bidi rule from RFC 5893
+// section 2, returning a description of the first rule that's violated.
+func bidiRule(runes []rune) string {
+	if len(runes) == 0 {
+		return ""
+	}
+	classes := make([]string, len(runes))
+	for i, r := range runes {
+		classes[i] = (unidata.Codepoint{Codepoint: r}).BidiClass()
+	}
+
+	// Last character that's not a NSM.
+	last := len(classes) - 1
+	for last > 0 && classes[last] == "NSM" {
+		last--
+	}
+
+	switch classes[0] {
+	case "R", "AL":
+		hasEN, hasAN := false, false
+		for i, c := range classes {
+			switch c {
+			case "R", "AL", "ES", "CS", "ET", "ON", "BN", "NSM":
+			case "EN":
+				hasEN = true
+			case "AN":
+				hasAN = true
+			default:
+				return fmt.Sprintf("right-to-left text can't contain %s (bidi class %s)", describeCodepoints(string(runes[i])), c)
+			}
+		}
+		if c := classes[last]; c != "R" && c != "AL" && c != "EN" && c != "AN" {
+			return fmt.Sprintf("right-to-left text can't end with %s (bidi class %s)", describeCodepoints(string(runes[last])), c)
+		}
+		if hasEN && hasAN {
+			return "right-to-left text can't contain both European and Arabic digits"
+		}
+	case "L":
+		for i, c := range classes {
+			switch c {
+			case "L", "EN", "ES", "CS", "ET", "ON", "BN", "NSM":
+			default:
+				return fmt.Sprintf("left-to-right text can't contain %s (bidi class %s)", describeCodepoints(string(runes[i])), c)
+			}
+		}
+		if c := classes[last]; c != "L" && c != "EN" {
+			return fmt.Sprintf("left-to-right text can't end with %s (bidi class %s)", describeCodepoints(string(runes[last])), c)
+		}
+	default:
+		return fmt.Sprintf("can't start with %s (bidi class %s)", describeCodepoints(string(runes[0])), classes[0])
+	}
+	return ""
+}
+
+// idnaWarnings gets warnings for labels that are valid, but may be used to
+// spoof other domains.
+func idnaWarnings(label string) []string {
+	var warn []string
+
+	if scripts, _ := mixedScripts([]rune(label)); scripts != nil {
+		names := make([]string, 0, len(scripts))
+		for _, s := range scripts {
+			names = append(names, s.String())
+		}
+		sort.Strings(names)
+		warn = append(warn, "mixed scripts: "+strings.Join(names, ", "))
+	}
+
+	if !isASCII(label) {
+		if sk := skeleton(label); isASCII(sk) {
+			warn = append(warn, fmt.Sprintf("confusable with %q", sk))
+		}
+	}
+	return warn
+}
+
+// Punycode parameters from RFC 3492.
+const (
+	punyBase        = 36
+	punyTmin        = 1
+	punyTmax        = 26
+	punySkew        = 38
+	punyDamp        = 700
+	punyInitialBias = 72
+	punyInitialN    = 128
+)
+
+func punyAdapt(delta, n int, first bool) int {
+	if first {
+		delta /= punyDamp
+	} else {
+		delta /= 2
+	}
+	delta += delta / n
+	k := 0
+	for delta > ((punyBase-punyTmin)*punyTmax)/2 {
+		delta /= punyBase - punyTmin
+		k += punyBase
+	}
+	return k + (punyBase-punyTmin+1)*delta/(delta+punySkew)
+}
+
+func punyThreshold(k, bias int) int {
+	switch {
+	case k <= bias:
+		return punyTmin
+	case k >= bias+punyTmax:
+		return punyTmax
+	}
+	return k - bias
+}
+
+func punyDigit(d int) byte {
+	if d < 26 {
+		return byte('a' + d)
+	}
+	return byte('0' + d - 26)
+}
+
+// punyEncode encodes s with punycode, without the "xn--" prefix.
+func punyEncode(s string) string {
+	var (
+		input = []rune(s)
+		out   = make([]byte, 0, len(s))
+	)
+	for _, r := range input {
+		if r < 0x80 {
+			out = append(out, byte(r))
+		}
+	}
+	h, basic := len(out), len(out)
+	if basic > 0 {
+		out = append(out, '-')
+	}
+
+	n, delta, bias := punyInitialN, 0, punyInitialBias
+	for h < len(input) {
+		m := rune(0x7fffffff)
+		for _, r := range input {
+			if int(r) >= n && r < m {
+				m = r
+			}
+		}
+		delta += (int(m) - n) * (h + 1)
+		n = int(m)
+		for _, r := range input {
+			if int(r) < n {
+				delta++
+			}
+			if int(r) != n {
+				continue
+			}
+			q := delta
+			for k := punyBase; ; k += punyBase {
+				t := punyThreshold(k, bias)
+				if q < t {
+					break
+				}
+				out = append(out, punyDigit(t+(q-t)%(punyBase-t)))
+				q = (q - t) / (punyBase - t)
+			}
+			out = append(out, punyDigit(q))
+			bias = punyAdapt(delta, h+1, h == basic)
+			delta = 0
+			h++
+		}
+		delta++
+		n++
+	}
+	return string(out)
+}
+
+// punyDecode decodes punycode, without the "xn--" prefix.
+func punyDecode(s string) (string, error) {
+	var (
+		out []rune
+		pos int
+	)
+	if b := strings.LastIndexByte(s, '-'); b > -1 {
+		for _, c := range s[:b] {
+			if c >= 0x80 {
+				return "", fmt.Errorf("non-ASCII character %q", c)
+			}
+			out = append(out, c)
+		}
+		pos = b + 1
+	}
+
+	n, i, bias := punyInitialN, 0, punyInitialBias
+	for pos < len(s) {
+		oldi, w := i, 1
+		for k := punyBase; ; k += punyBase {
+			if pos >= len(s) {
+				return "", errors.New("unexpected end of input")
+			}
+			var digit int
+			switch c := s[pos]; {
+			case c >= 'a' && c <= 'z':
+				digit = int(c - 'a')
+			case c >= 'A' && c <= 'Z':
+				digit = int(c - 'A')
+			case c >= '0' && c <= '9':
+				digit = int(c-'0') + 26
+			default:
+				return "", fmt.Errorf("invalid character %q", c)
+			}
+			pos++
+
+			i += digit * w
+			if i > 0x10ffff*(len(out)+1) {
+				return "", errors.New("overflow")
+			}
+			t := punyThreshold(k, bias)
+			if digit < t {
+				break
+			}
+			w *= punyBase - t
+		}
+		bias = punyAdapt(i-oldi, len(out)+1, oldi == 0)
+		n += i / (len(out) + 1)
+		i %= len(out) + 1
+		if n > 0x10ffff {
+			return "", errors.New("overflow")
+		}
+		out = append(out, 0)
+		copy(out[i+1:], out[i:])
+		out[i] = rune(n)
+		i++
+	}
+	return string(out), nil
+}
@@ -0,0 +1,641 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+	"zgo.at/uni/v2/unidata"
+	"zgo.at/zli"
+)
+
+// errLintFound is returned if there were any findings; this doesn't print an
+// error, but exits with 1.
+var errLintFound = fmt.Errorf("lint: found problems: %w", errSilent)
+
+const (
+	sevError   = "error"
+	sevWarning = "warning"
+)
+
+// lintChecks are all the checks that lint does.
+var lintChecks = []struct {
+	name, desc string
+}{
+	{"bidi", "Bidirectional control character; these can be used to make code look different from what it is (\"Trojan Source\")"},
+	{"invisible", "Zero-width or other default-ignorable character that isn't displayed"},
+	{"mixed-script", "Identifier that mixes scripts, such as Latin and Cyrillic"},
+	{"nfc", "Text that isn't in Normalization Form C"},
+	{"control", "Unexpected C0 or C1 control character"},
+	{"bom", "Byte order mark at the start of the file"},
+	{"utf8", "Invalid UTF-8"},
+}
+
+type lintFinding struct {
+	Path     string `json:"path"`
+	Line     int    `json:"line"`
+	Col      int    `json:"col"` // In codepoints, starting at 1.
+	Check    string `json:"check"`
+	Severity string `json:"severity"`
+	Cpoint   string `json:"cpoint,omitempty"`
+	Name     string `json:"name,omitempty"`
+	Message  string `json:"message"`
+
+	cp rune
+}
+
+// allowRule is an entry in the allow-list: a check name or set of codepoints,
+// optionally only for paths matching a glob.
+type allowRule struct {
+	glob  string
+	check string
+	set   rangeSet
+}
+
+type linter struct {
+	allow    []allowRule
+	findings []lintFinding
+}
+
+var (
+	defaultIgnorable = newRangeSet(unidata.DerivedProperties[unidata.DerivedDefaultIgnorableCodePoint].Ranges...)
+	bidiControl      = newRangeSet(unidata.Properties[unidata.PropBidiControl].Ranges...)
+)
+
+func lint(args []string, as string, compact, diff bool, allowFile string) error {
+	l := linter{}
+	if allowFile != "" {
+		var err error
+		l.allow, err = readAllowList(allowFile)
+		if err != nil {
+			return err
+		}
+	}
+
+	switch {
+	case len(args) == 0:
+		if fp, ok := zli.Stdin.(*os.File); ok && zli.IsTerminal(fp.Fd()) {
+			return errors.New("lint: need a file or directory, or text on stdin")
+		}
+		text, err := io.ReadAll(zli.Stdin)
+		if err != nil {
+			return err
+		}
+		if diff {
+			l.diff(text)
+		} else {
+			l.text("-", text)
+		}
+	case diff:
+		for _, a := range args {
+			text, err := os.ReadFile(a)
+			if err != nil {
+				return err
+			}
+			l.diff(text)
+		}
+	default:
+		for _, a := range args {
+			err := l.path(a)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	switch as {
+	default:
+		return fmt.Errorf("unknown value for -as: %q; lint only supports list, json, and sarif", as)
+	case "l", "list":
+		for _, f := range l.findings {
+			fmt.Fprintf(zli.Stdout, "%s:%d:%d: %s: %s (%s)\n", f.Path, f.Line, f.Col, f.Severity, f.Message, f.Check)
+		}
+		if !compact && len(l.findings) > 0 {
+			fmt.Fprintln(zli.Stderr, l.summary())
+		}
+	case "j", "json":
+		out := l.findings
+		if out == nil {
+			out = []lintFinding{}
+		}
+		writeJSON(zli.Stdout, out, compact)
+	case "sarif":
+		writeJSON(zli.Stdout, l.sarif(), compact)
+	}
+
+	if len(l.findings) > 0 {
+		return errLintFound
+	}
+	return nil
+}
+
+func writeJSON(out io.Writer, v interface{}, compact bool) {
+	enc := json.NewEncoder(out)
+	enc.SetEscapeHTML(false)
+	if !compact {
+		enc.SetIndent("", "\t")
+	}
+	enc.Encode(v)
+}
+
+func (l linter) summary() string {
+	var nerr, nwarn int
+	files := make(map[string]struct{})
+	for _, f := range l.findings {
+		files[f.Path] = struct{}{}
+		if f.Severity == sevError {
+			nerr++
+		} else {
+			nwarn++
+		}
+	}
+	return fmt.Sprintf("uni lint: %d problems in %d files (%d errors, %d warnings)",
+		len(l.findings), len(files), nerr, nwarn)
+}
+
+// readAllowList reads the allow-list file. Every line is a check name or
+// anything the print command accepts (U+200D, U+2066..U+2069, cat:Cf, etc.),
+// optionally prefixed with a glob to match the path. Lines starting with # are
+// comments.
+//
+//	# Allow ZWJ everywhere, and NFC problems in the testdata.
+//	U+200D
+//	testdata/* nfc
+func readAllowList(file string) ([]allowRule, error) {
+	fp, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer fp.Close()
+
+	var (
+		rules  []allowRule
+		lineno int
+		scan   = bufio.NewScanner(fp)
+	)
+	for scan.Scan() {
+		lineno++
+		line := strings.TrimSpace(scan.Text())
+		if line == "" || line[0] == '#' {
+			continue
+		}
+
+		var r allowRule
+		if f := strings.Fields(line); len(f) > 1 && !isQuery(line) {
+			r.glob, line = f[0], strings.TrimSpace(line[len(f[0]):])
+		}
+		for _, c := range lintChecks {
+			if line == c.name {
+				r.check = line
+				break
+			}
+		}
+		if r.check == "" {
+			r.set, err = selection(line)
+			if err != nil {
+				return nil, fmt.Errorf("%s:%d: %w", file, lineno, err)
+			}
+		}
+		rules = append(rules, r)
+	}
+	return rules, scan.Err()
+}
+
+// path lints a file, or all files in a directory. Hidden directories such as
+// .git and binary files are skipped.
+func (l *linter) path(path string) error {
+	st, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	if !st.IsDir() {
+		return l.file(path, false)
+	}
+
+	return filepath.WalkDir(path, func(p string, d os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if p != path && strings.HasPrefix(d.Name(), ".") {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		return l.file(p, true)
+	})
+}
+
+func (l *linter) file(path string, skipBinary bool) error {
+	text, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if skipBinary && isBinary(text) {
+		return nil
+	}
+	l.text(path, text)
+	return nil
+}
+
+// isBinary guesses if a file is binary; like git it looks for a NUL byte in
+// the first 8000 bytes.
+func isBinary(text []byte) bool {
+	if len(text) > 8000 {
+		text = text[:8000]
+	}
+	return bytes.IndexByte(text, 0) > -1
+}
+
+func (l *linter) text(path string, text []byte) {
+	for i, line := range bytes.Split(text, []byte{'\n'}) {
+		l.line(path, i+1, line, i == 0)
+	}
+}
+
+// diff lints only the added lines in a unified diff, such as the output of git
+// diff. Findings are reported with the path and line number of the new file.
+func (l *linter) diff(text []byte) {
+	var (
+		path         string
+		lineno       int
+		nOld, nNew   int // Lines left in the hunk.
+		hunkRangeLen = func(r string) (int, int) {
+			s := strings.SplitN(r[1:], ",", 2)
+			start, _ := strconv.Atoi(s[0])
+			n := 1
+			if len(s) > 1 {
+				n, _ = strconv.Atoi(s[1])
+			}
+			return start, n
+		}
+	)
+	for _, line := range bytes.Split(text, []byte{'\n'}) {
+		if nOld <= 0 && nNew <= 0 {
+			switch {
+			case bytes.HasPrefix(line, []byte("+++ ")):
+				path = diffPath(string(line[4:]))
+			case bytes.HasPrefix(line, []byte("@@ ")):
+				// @@ -1,5 +1,6 @@ optional section heading
+				f := strings.Fields(string(line))
+				if len(f) < 3 || f[1][0] != '-' || f[2][0] != '+' || path == "" {
+					continue
+				}
+				_, nOld = hunkRangeLen(f[1])
+				lineno, nNew = hunkRangeLen(f[2])
+			}
+			continue
+		}
+
+		switch {
+		case len(line) == 0 || line[0] == ' ':
+			lineno++
+			nOld--
+			nNew--
+		case line[0] == '-':
+			nOld--
+		case line[0] == '+':
+			l.line(path, lineno, bytes.TrimSuffix(line[1:], []byte{'\r'}), lineno == 1)
+			lineno++
+			nNew--
+		}
+	}
+}
+
+// diffPath gets the path from the +++ line in a diff: remove the timestamp
+// that diff -u adds, unquote paths that git quoted, and remove the b/ prefix.
+func diffPath(p string) string {
+	if i := strings.IndexByte(p, '\t'); i > -1 {
+		p = p[:i]
+	}
+	if strings.HasPrefix(p, `"`) {
+		if u, err := strconv.Unquote(p); err == nil {
+			p = u
+		}
+	}
+	if p == "/dev/null" {
+		return ""
+	}
+	return strings.TrimPrefix(p, "b/")
+}
+
+// line lints a single line, without the trailing newline. bof indicates this
+// is the first line of the file.
+func (l *linter) line(path string, lineno int, line []byte, bof bool) {
+	add := func(col int, check, sev string, cp rune, msg string) {
+		f := lintFinding{Path: path, Line: lineno, Col: col, Check: check, Severity: sev, Message: msg, cp: cp}
+		if cp > -1 {
+			info, _ := unidata.Find(cp)
+			f.Cpoint, f.Name = info.FormatCodepoint(), info.Name()
+			if msg == "" {
+				f.Message = f.Cpoint + " " + f.Name
+			}
+		}
+		if !l.allowed(f) {
+			l.findings = append(l.findings, f)
+		}
+	}
+
+	off := 0
+	if bof && bytes.HasPrefix(line, []byte("\xef\xbb\xbf")) {
+		add(1, "bom", sevWarning, 0xfeff, "")
+		off = 3
+	}
+
+	var (
+		col       = off / 3 // 1 if there's a BOM.
+		prev      rune
+		ident     []rune
+		identCol  int
+		lineRunes = make([]rune, 0, len(line))
+	)
+	for i := off; i < len(line); {
+		r, size := utf8.DecodeRune(line[i:])
+		col++
+		if r == utf8.RuneError && size == 1 {
+			add(col, "utf8", sevError, -1, fmt.Sprintf("invalid UTF-8 byte 0x%02x", line[i]))
+			i++
+			prev = r
+			l.identifier(add, ident, identCol)
+			ident = ident[:0]
+			continue
+		}
+		i += size
+		lineRunes = append(lineRunes, r)
+
+		if isIdentRune(r) {
+			if len(ident) == 0 {
+				identCol = col
+			}
+			ident = append(ident, r)
+		} else if !(r == 0x200c || r == 0x200d) || len(ident) == 0 { // Joiners can be part of an identifier.
+			l.identifier(add, ident, identCol)
+			ident = ident[:0]
+		}
+
+		var next rune
+		if i < len(line) {
+			next, _ = utf8.DecodeRune(line[i:])
+		}
+		switch {
+		case r < 0x80 && r >= 0x20 && r != 0x7f: // Common case.
+		case r < 0x20 || r == 0x7f:
+			if r != '\t' && r != '\f' && !(r == '\r' && i == len(line)) {
+				add(col, "control", sevError, r, "")
+			}
+		case r >= 0x80 && r <= 0x9f:
+			add(col, "control", sevError, r, "")
+		case bidiControl.contains(r):
+			sev := sevError
+			if r == 0x061c || r == 0x200e || r == 0x200f { // Marks are fairly harmless.
+				sev = sevWarning
+			}
+			add(col, "bidi", sev, r, "")
+		case defaultIgnorable.contains(r) && !ignorableOK(prev, r, next):
+			add(col, "invisible", sevWarning, r, "")
+		}
+		prev = r
+	}
+	l.identifier(add, ident, identCol)
+
+	l.nfc(add, string(lineRunes), off/3)
+}
+
+// nfc checks if the line is in NFC; only the first problem is reported.
+func (l *linter) nfc(add func(int, string, string, rune, string), s string, col int) {
+	if norm.NFC.IsNormalString(s) {
+		return
+	}
+
+	cpoints := func(s string) string {
+		c := make([]string, 0, 2)
+		for _, r := range s {
+			c = append(c, fmt.Sprintf("U+%04X", r))
+		}
+		return strings.Join(c, " ")
+	}
+	for s != "" {
+		b := norm.NFC.NextBoundaryInString(s, true)
+		seg := s[:b]
+		if !norm.NFC.IsNormalString(seg) {
+			r, _ := utf8.DecodeRuneInString(seg)
+			add(col+1, "nfc", sevWarning, r,
+				fmt.Sprintf("not in NFC: %s should be %s", cpoints(seg), cpoints(norm.NFC.String(seg))))
+			return
+		}
+		col += utf8.RuneCountInString(seg)
+		s = s[b:]
+	}
+}
+
+func isIdentRune(r rune) bool {
+	if r < 0x80 {
+		return r == '_' || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9')
+	}
+	info, _ := unidata.Find(r)
+	switch info.Category() {
+	case unidata.CatUppercaseLetter, unidata.CatLowercaseLetter, unidata.CatTitlecaseLetter,
+		unidata.CatModifierLetter, unidata.CatOtherLetter,
+		unidata.CatNonspacingMark, unidata.CatSpacingMark, unidata.CatDecimalNumber,
+		unidata.CatLetterNumber, unidata.CatConnectorPunctuation:
+		return true
+	}
+	return false
+}
+
+// ignorableOK reports if the default-ignorable character r is expected here:
+// ZWJ and variation selectors in emoji sequences, tags in flag sequences, and
+// joiners between letters of a script that uses them.
+func ignorableOK(prev, r, next rune) bool {
+	switch {
+	case r >= 0xe0020 && r <= 0xe007f: // Tags, as in 🏴󠁧󠁢󠁳󠁣󠁴󠁿.
+		return prev == 0x1f3f4 || (prev >= 0xe0020 && prev <= 0xe007f)
+	case r == 0xfe0e || r == 0xfe0f:
+		return prev >= 0x80 || prev == '#' || prev == '*' || (prev >= '0' && prev <= '9')
+	case r == 0x200d && (prev == 0xfe0f || isEmoji(prev)) && isEmoji(next):
+		return true
+	case r == 0x200c || r == 0x200d:
+		if prev < 0x80 || next < 0x80 {
+			return false
+		}
+		a, _ := unidata.Find(prev)
+		b, _ := unidata.Find(next)
+		return a.Script() == b.Script() && a.Script() != unidata.ScriptCommon && a.Script() != unidata.ScriptLatin
+	}
+	return false
+}
+
+func isEmoji(r rune) bool {
+	if r < 0x80 {
+		return false
+	}
+	info, _ := unidata.Find(r)
+	return info.Category() == unidata.CatOtherSymbol || (r >= 0x1f3fb && r <= 0x1f3ff)
+}
+
+// Scripts that can be mixed; this is the "Highly Restrictive" level from UTS
+// #39.
+var mixableScripts = [][]unidata.Script{
+	{unidata.ScriptLatin, unidata.ScriptHan, unidata.ScriptHiragana, unidata.ScriptKatakana},
+	{unidata.ScriptLatin, unidata.ScriptHan, unidata.ScriptBopomofo},
+	{unidata.ScriptLatin, unidata.ScriptHan, unidata.ScriptHangul},
+}
+
+// identifier checks if an identifier mixes scripts.
+func (l *linter) identifier(add func(int, string, string, rune, string), ident []rune, col int) {
+	scripts, first := mixedScripts(ident)
+	if scripts == nil {
+		return
+	}
+
+	names := make([]string, 0, len(scripts))
+	for _, s := range scripts {
+		names = append(names, s.String())
+	}
+	add(col+first, "mixed-script", sevWarning, ident[first],
+		fmt.Sprintf("identifier %q mixes %s", string(ident), strings.Join(names, " and ")))
+}
+
+// mixedScripts gets the scripts in ident if they can't be mixed, and the index
+// of the first codepoint of the second script. The scripts are nil if ident
+// doesn't mix scripts, or only mixes scripts in mixableScripts.
+func mixedScripts(ident []rune) ([]unidata.Script, int) {
+	var (
+		scripts []unidata.Script
+		first   = -1
+	)
+	for i, r := range ident {
+		s := unidata.ScriptLatin
+		if r >= 0x80 {
+			info, _ := unidata.Find(r)
+			s = info.Script()
+		} else if r == '_' || (r >= '0' && r <= '9') {
+			continue
+		}
+		if s == unidata.ScriptCommon || s == unidata.ScriptInherited || s == unidata.ScriptUnknown {
+			continue
+		}
+		if !scriptIn(s, scripts) {
+			scripts = append(scripts, s)
+			if len(scripts) == 2 {
+				first = i
+			}
+		}
+	}
+	if len(scripts) < 2 {
+		return nil, -1
+	}
+	for _, m := range mixableScripts {
+		ok := true
+		for _, s := range scripts {
+			ok = ok && scriptIn(s, m)
+		}
+		if ok {
+			return nil, -1
+		}
+	}
+	return scripts, first
+}
+
+func scriptIn(s unidata.Script, list []unidata.Script) bool {
+	for _, l := range list {
+		if s == l {
+			return true
+		}
+	}
+	return false
+}
+
+func (l linter) allowed(f lintFinding) bool {
+	for _, r := range l.allow {
+		if r.glob != "" {
+			m, _ := filepath.Match(r.glob, f.Path)
+			if !m {
+				m, _ = filepath.Match(r.glob, filepath.Base(f.Path))
+			}
+			if !m {
+				continue
+			}
+		}
+		if r.check == f.Check || (r.set != nil && f.Cpoint != "" && r.set.contains(f.cp)) {
+			return true
+		}
+	}
+	return false
+}
+
+// sarif converts the findings to a SARIF 2.1.0 log.
+func (l linter) sarif() interface{} {
+	type (
+		msg struct {
+			Text string `json:"text"`
+		}
+		region struct {
+			StartLine   int `json:"startLine"`
+			StartColumn int `json:"startColumn"`
+		}
+		location struct {
+			PhysicalLocation struct {
+				ArtifactLocation struct {
+					URI string `json:"uri"`
+				} `json:"artifactLocation"`
+				Region region `json:"region"`
+			} `json:"physicalLocation"`
+		}
+		result struct {
+			RuleID    string     `json:"ruleId"`
+			Level     string     `json:"level"`
+			Message   msg        `json:"message"`
+			Locations []location `json:"locations"`
+		}
+		rule struct {
+			ID               string `json:"id"`
+			ShortDescription msg    `json:"shortDescription"`
+		}
+	)
+
+	rules := make([]rule, 0, len(lintChecks))
+	for _, c := range lintChecks {
+		rules = append(rules, rule{ID: c.name, ShortDescription: msg{c.desc}})
+	}
+	results := make([]result, 0, len(l.findings))
+	for _, f := range l.findings {
+		var loc location
+		loc.PhysicalLocation.ArtifactLocation.URI = filepath.ToSlash(f.Path)
+		loc.PhysicalLocation.Region = region{f.Line, f.Col}
+		results = append(results, result{
+			RuleID:    f.Check,
+			Level:     f.Severity,
+			Message:   msg{f.Message},
+			Locations: []location{loc},
+		})
+	}
+	return map[string]interface{}{
+		"$schema": "https://json.schemastore.org/sarif-2.1.0.json",
+		"version": "2.1.0",
+		"runs": []interface{}{map[string]interface{}{
+			"tool": map[string]interface{}{
+				"driver": map[string]interface{}{
+					"name":           "uni",
+					"informationUri": "https://github.com/arp242/uni",
+					"version":        version,
+					"rules":          rules,
+				},
+			},
+			"columnKind": "unicodeCodePoints",
+			"results":    results,
+		}},
+	}
+}
@@ -0,0 +1,315 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"zgo.at/uni/v2/unidata"
+	"zgo.at/zstd/zstring"
+)
+
+// ordering is how to sort, group, and page through results; this is set from
+// the -sort, -reverse, -group-by, -limit, and -offset flags.
+type ordering struct {
+	sort    string // Sort by; "" to keep the default order.
+	reverse bool   // Reverse the sort order.
+	groupBy string // Group by; "" to not group.
+	limit   int    // Show at most this many results (per group); 0 is no limit.
+	offset  int    // Skip this many results (per group).
+}
+
+var (
+	sortKeys  = []string{"name", "cpoint", "block", "cat", "script", "age"}
+	groupKeys = []string{"block", "script", "cat", "group", "subgroup"}
+)
+
+func (o ordering) set() bool {
+	return o.sort != "" || o.reverse || o.groupBy != "" || o.limit > 0 || o.offset > 0
+}
+
+// page gets the start and end index to display for a list with n items.
+func (o ordering) page(n int) (int, int) {
+	start := o.offset
+	if start > n {
+		start = n
+	}
+	end := n
+	if o.limit > 0 && start+o.limit < n {
+		end = start + o.limit
+	}
+	return start, end
+}
+
+// groupHeader gets the header to print for a group.
+func (o ordering) groupHeader(name string, shown, total int) string {
+	kind := zstring.UpperFirst(o.groupBy)
+	if o.groupBy == "cat" {
+		kind = "Category"
+	}
+	if shown != total {
+		return fmt.Sprintf("%s %s (%d of %d)", kind, name, shown, total)
+	}
+	return fmt.Sprintf("%s %s (%d)", kind, name, total)
+}
+
+// Codepoint adds a line for a codepoint. This is added right away, unless the
+// results need to be sorted or grouped, in which case it's done in Print().
+func (f *Format) Codepoint(info unidata.Codepoint, raw bool) {
+	if f.order.set() {
+		f.cps, f.raw = append(f.cps, info), raw
+		return
+	}
+	f.addCodepoint(info, raw)
+}
+
+func (f *Format) addCodepoint(info unidata.Codepoint, raw bool) {
+	if f.tmpl != nil {
+		c := tmplCodepoint{Codepoint: info, Rune: info.Codepoint, Char: info.Display()}
+		if raw {
+			c.Char = string(info.Codepoint)
+		}
+		f.execTemplate(c)
+		return
+	}
+	f.Line(f.toLine(info, raw))
+}
+
+// Emoji adds a line for an emoji.
+func (f *Format) Emoji(e unidata.Emoji) {
+	if f.tmpl != nil {
+		f.execTemplate(tmplEmoji{Emoji: e, Char: e.String()})
+		return
+	}
+	f.Line(emojiLine(e))
+}
+
+// Group starts a new group of lines, which are printed with a header in list
+// output.
+func (f *Format) Group(header string) {
+	if f.groups == nil {
+		f.groups = make(map[int]string)
+	}
+	f.groups[len(f.lines)] = header
+}
+
+// DefaultSort sets the sort order to use if -sort isn't given.
+func (f *Format) DefaultSort(key string) {
+	if f.order.sort == "" {
+		f.order.sort = key
+	}
+}
+
+// checkOrder checks if the -sort and -group-by flags are valid for the type of
+// results.
+func (f *Format) checkOrder() error {
+	switch {
+	case f.emoji && f.order.sort != "" && f.order.sort != "name" && f.order.sort != "cpoint":
+		return fmt.Errorf("can't use -sort %s with the emoji command", f.order.sort)
+	case f.emoji && f.order.groupBy != "" && f.order.groupBy != "group" && f.order.groupBy != "subgroup":
+		return fmt.Errorf("can't use -group-by %s with the emoji command", f.order.groupBy)
+	case !f.emoji && (f.order.groupBy == "group" || f.order.groupBy == "subgroup"):
+		return fmt.Errorf("can only use -group-by %s with the emoji command", f.order.groupBy)
+	}
+	return nil
+}
+
+// orderCodepoints adds the lines for all the codepoints added with Codepoint(),
+// sorted and grouped.
+func (f *Format) orderCodepoints() {
+	cps := f.cps
+	f.cps = nil
+	sortCodepoints(cps, f.order.sort)
+	if f.order.reverse {
+		for i, j := 0, len(cps)-1; i < j; i, j = i+1, j-1 {
+			cps[i], cps[j] = cps[j], cps[i]
+		}
+	}
+
+	for _, g := range groupCodepoints(cps, f.order.groupBy) {
+		start, end := f.order.page(len(g.cps))
+		if start == end {
+			continue
+		}
+		if f.order.groupBy != "" {
+			f.Group(f.order.groupHeader(g.name, end-start, len(g.cps)))
+		}
+		for _, c := range g.cps[start:end] {
+			f.addCodepoint(c, f.raw)
+		}
+	}
+}
+
+// orderLines sorts and pages lines that were already added; this is used for
+// the list command, which can only sort by name.
+func (f *Format) orderLines() error {
+	switch f.order.sort {
+	case "", "cpoint":
+	case "name":
+		f.Sort("name")
+	default:
+		return fmt.Errorf("can't use -sort %s with the list command", f.order.sort)
+	}
+	if f.order.groupBy != "" {
+		return fmt.Errorf("can't use -group-by with the list command")
+	}
+
+	lines := f.lines
+	if f.as == printAsList && len(lines) > 0 { // Don't include header.
+		lines = lines[1:]
+	}
+	if f.order.reverse {
+		for i, j := 0, len(lines)-1; i < j; i, j = i+1, j-1 {
+			lines[i], lines[j] = lines[j], lines[i]
+		}
+	}
+	start, end := f.order.page(len(lines))
+	if f.as == printAsList && len(f.lines) > 0 {
+		f.lines = append(f.lines[:1], lines[start:end]...)
+	} else {
+		f.lines = lines[start:end]
+	}
+	return nil
+}
+
+func sortCodepoints(cps []unidata.Codepoint, key string) {
+	var less func(a, b unidata.Codepoint) bool
+	switch key {
+	case "": // Keep order.
+		return
+	case "cpoint":
+		less = func(a, b unidata.Codepoint) bool { return false }
+	case "name":
+		less = func(a, b unidata.Codepoint) bool { return a.Name() < b.Name() }
+	case "block":
+		less = func(a, b unidata.Codepoint) bool { return a.Block().String() < b.Block().String() }
+	case "cat":
+		less = func(a, b unidata.Codepoint) bool { return a.Category().String() < b.Category().String() }
+	case "script":
+		less = func(a, b unidata.Codepoint) bool { return a.Script().String() < b.Script().String() }
+	case "age":
+		less = func(a, b unidata.Codepoint) bool { return ageLess(a.Age(), b.Age()) }
+	}
+
+	sort.SliceStable(cps, func(i, j int) bool {
+		if less(cps[i], cps[j]) {
+			return true
+		}
+		if less(cps[j], cps[i]) {
+			return false
+		}
+		return cps[i].Codepoint < cps[j].Codepoint
+	})
+}
+
+// ageLess reports if Unicode version a is lower than b.
+func ageLess(a, b string) bool {
+	ver := func(v string) (int, int) {
+		s := strings.SplitN(v+".", ".", 3)
+		major, _ := strconv.Atoi(s[0])
+		minor, _ := strconv.Atoi(s[1])
+		return major, minor
+	}
+	amaj, amin := ver(a)
+	bmaj, bmin := ver(b)
+	return amaj < bmaj || (amaj == bmaj && amin < bmin)
+}
+
+type cpGroup struct {
+	name string
+	cps  []unidata.Codepoint
+}
+
+// groupCodepoints groups the codepoints, keeping the order inside every group.
+// Blocks are ordered by their codepoint, and categories and scripts by name.
+func groupCodepoints(cps []unidata.Codepoint, key string) []cpGroup {
+	if key == "" {
+		return []cpGroup{{cps: cps}}
+	}
+
+	var (
+		groups  []cpGroup
+		idx     = make(map[string]int)
+		orderBy = make(map[string]rune)
+	)
+	for _, c := range cps {
+		var name string
+		switch key {
+		case "block":
+			name = c.Block().String()
+			orderBy[name] = unidata.Blocks[c.Block()].Range[0]
+		case "cat":
+			name = c.Category().String()
+		case "script":
+			name = c.Script().String()
+		}
+
+		i, ok := idx[name]
+		if !ok {
+			i = len(groups)
+			idx[name] = i
+			groups = append(groups, cpGroup{name: name})
+		}
+		groups[i].cps = append(groups[i].cps, c)
+	}
+
+	sort.SliceStable(groups, func(i, j int) bool {
+		if key == "block" {
+			return orderBy[groups[i].name] < orderBy[groups[j].name]
+		}
+		return groups[i].name < groups[j].name
+	})
+	return groups
+}
+
+type emojiGroup struct {
+	name   string
+	emojis []unidata.Emoji
+}
+
+// orderEmojis sorts and groups emojis. The groups are in the order they first
+// appear.
+func orderEmojis(emojis []unidata.Emoji, o ordering) []emojiGroup {
+	switch o.sort {
+	case "name":
+		sort.SliceStable(emojis, func(i, j int) bool { return emojis[i].Name < emojis[j].Name })
+	case "cpoint":
+		sort.SliceStable(emojis, func(i, j int) bool {
+			a, b := emojis[i].Codepoints, emojis[j].Codepoints
+			for k := 0; k < len(a) && k < len(b); k++ {
+				if a[k] != b[k] {
+					return a[k] < b[k]
+				}
+			}
+			return len(a) < len(b)
+		})
+	}
+	if o.reverse {
+		for i, j := 0, len(emojis)-1; i < j; i, j = i+1, j-1 {
+			emojis[i], emojis[j] = emojis[j], emojis[i]
+		}
+	}
+
+	if o.groupBy == "" {
+		return []emojiGroup{{emojis: emojis}}
+	}
+	var (
+		groups []emojiGroup
+		idx    = make(map[string]int)
+	)
+	for _, e := range emojis {
+		name := e.Group().String()
+		if o.groupBy == "subgroup" {
+			name = e.Subgroup().String()
+		}
+		i, ok := idx[name]
+		if !ok {
+			i = len(groups)
+			idx[name] = i
+			groups = append(groups, emojiGroup{name: name})
+		}
+		groups[i].emojis = append(groups[i].emojis, e)
+	}
+	return groups
+}
@@ -0,0 +1,311 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+	"golang.org/x/text/unicode/norm"
+	"zgo.at/uni/v2/unidata"
+	"zgo.at/zli"
+)
+
+// errPRECISInvalid is returned if any of the strings are invalid; this doesn't
+// print an error, but exits with 1.
+var errPRECISInvalid = fmt.Errorf("precis: invalid string: %w", errSilent)
+
+var precisProfiles = []string{"usernamecasemapped", "usernamecasepreserved", "opaquestring", "nickname"}
+
+// Derived property values from RFC 8264 section 8.
+const (
+	precisPValid     = "PVALID"
+	precisFreePValid = "ID_DIS or FREE_PVAL" // Disallowed in IdentifierClass, valid in FreeformClass.
+	precisContextJ   = "CONTEXTJ"
+	precisContextO   = "CONTEXTO"
+	precisDisallowed = "DISALLOWED"
+	precisUnassigned = "UNASSIGNED"
+)
+
+// Exceptions from RFC 5892 section 2.6, which are used as-is by PRECIS.
+var precisExceptions = map[rune]string{
+	0x00df: precisPValid, 0x03c2: precisPValid, 0x06fd: precisPValid,
+	0x06fe: precisPValid, 0x0f0b: precisPValid, 0x3007: precisPValid,
+
+	0x00b7: precisContextO, 0x0375: precisContextO, 0x05f3: precisContextO,
+	0x05f4: precisContextO, 0x30fb: precisContextO,
+
+	0x0640: precisDisallowed, 0x07fa: precisDisallowed, 0x302e: precisDisallowed,
+	0x302f: precisDisallowed, 0x3031: precisDisallowed, 0x3032: precisDisallowed,
+	0x3033: precisDisallowed, 0x3034: precisDisallowed, 0x3035: precisDisallowed,
+	0x303b: precisDisallowed,
+}
+
+var (
+	// Hangul_Syllable_Type L, V, and T.
+	oldHangulJamo = newRangeSet([2]rune{0x1100, 0x11ff}, [2]rune{0xa960, 0xa97c},
+		[2]rune{0xd7b0, 0xd7c6}, [2]rune{0xd7cb, 0xd7fb})
+	noncharacter = newRangeSet(unidata.Properties[unidata.PropNoncharacterCodePoint].Ranges...)
+	joinControl  = newRangeSet(unidata.Properties[unidata.PropJoinControl].Ranges...)
+)
+
+type (
+	precisResult struct {
+		Input      string            `json:"input"`
+		Output     string            `json:"output"` // Empty if invalid.
+		Disallowed []precisCodepoint `json:"disallowed"`
+		Errors     []string          `json:"errors"` // For the entire string, rather than a codepoint.
+	}
+	precisCodepoint struct {
+		Cpoint   string `json:"cpoint"`
+		Name     string `json:"name"`
+		Property string `json:"property"`
+	}
+)
+
+func (r precisResult) valid() bool { return len(r.Disallowed) == 0 && len(r.Errors) == 0 }
+
+func precisCmd(args []string, profile string, as printAs) error {
+	if profile == "" {
+		profile = "usernamecasemapped"
+	}
+	p, err := match(profile, precisProfiles...)
+	if err != nil {
+		return fmt.Errorf("precis: unknown profile %q; one of: %s", profile, strings.Join(precisProfiles, ", "))
+	}
+
+	results := make([]precisResult, 0, len(args))
+	for _, a := range args {
+		results = append(results, precis(a, p))
+	}
+	if len(results) == 0 {
+		return errors.New("precis: need a string")
+	}
+
+	switch as {
+	case printAsJSON, printAsJSONCompact:
+		writeJSON(zli.Stdout, results, as == printAsJSONCompact)
+	case printAsListCompact:
+		for _, r := range results {
+			if r.valid() {
+				fmt.Fprintln(zli.Stdout, r.Output)
+			}
+		}
+	case printAsList:
+		for i, r := range results {
+			if i > 0 {
+				fmt.Fprintln(zli.Stdout)
+			}
+			fmt.Fprintf(zli.Stdout, "input:       %s\n", r.Input)
+			if r.valid() {
+				fmt.Fprintf(zli.Stdout, "output:      %s\n", r.Output)
+			}
+			for _, d := range r.Disallowed {
+				fmt.Fprintf(zli.Stdout, "disallowed:  %s %s (%s)\n", d.Cpoint, d.Name, d.Property)
+			}
+			for _, e := range r.Errors {
+				fmt.Fprintf(zli.Stdout, "error:       %s\n", e)
+			}
+		}
+	default:
+		return errors.New("precis only supports -as list and json")
+	}
+
+	for _, r := range results {
+		if !r.valid() {
+			return errPRECISInvalid
+		}
+	}
+	return nil
+}
+
+// precis enforces the profile on s, according to RFC 8265 for the username and
+// password profiles, and RFC 8266 for nicknames.
+func precis(s, profile string) precisResult {
+	res := precisResult{Input: s, Disallowed: []precisCodepoint{}, Errors: []string{}}
+
+	var (
+		out       string
+		freeform  = profile == "opaquestring" || profile == "nickname"
+		checkBidi = profile == "usernamecasemapped" || profile == "usernamecasepreserved"
+	)
+	switch profile {
+	case "usernamecasemapped":
+		// PRECIS doesn't use the special handling for the Greek final sigma,
+		// so "Σ" is always "σ" (and never "ς").
+		out = norm.NFC.String(cases.Lower(language.Und, cases.HandleFinalSigma(false)).String(precisWidth(s)))
+	case "usernamecasepreserved":
+		out = norm.NFC.String(precisWidth(s))
+	case "opaquestring":
+		out = norm.NFC.String(precisSpaces(s))
+	case "nickname":
+		// NFKC can introduce new spaces (e.g. U+2474 PARENTHESIZED DIGIT
+		// ONE is "(1)"), so apply the rules until the result is stable, as
+		// RFC 8266 section 2.3 describes.
+		out = s
+		for i := 0; ; i++ {
+			n := norm.NFKC.String(strings.Join(strings.FieldsFunc(precisSpaces(out), func(r rune) bool { return r == ' ' }), " "))
+			if n == out {
+				break
+			}
+			if i == 4 {
+				res.Errors = append(res.Errors, "result isn't stable after applying the rules repeatedly")
+				break
+			}
+			out = n
+		}
+	}
+
+	if out == "" {
+		res.Errors = append(res.Errors, "empty string")
+		return res
+	}
+
+	var (
+		runes = []rune(out)
+		seen  = make(map[rune]bool)
+		rtl   bool
+	)
+	for i, r := range runes {
+		prop := precisProperty(r)
+		ok := prop == precisPValid || (freeform && prop == precisFreePValid)
+		switch prop {
+		case precisContextJ:
+			ok = contextJ(runes, i)
+		case precisContextO:
+			ok = contextO(runes, i)
+		}
+		if !ok && !seen[r] {
+			seen[r] = true
+			info, _ := unidata.Find(r)
+			res.Disallowed = append(res.Disallowed, precisCodepoint{
+				Cpoint:   info.FormatCodepoint(),
+				Name:     info.Name(),
+				Property: prop,
+			})
+		}
+
+		switch (unidata.Codepoint{Codepoint: r}).BidiClass() {
+		case "R", "AL", "AN":
+			rtl = true
+		}
+	}
+
+	if checkBidi && rtl {
+		if e := bidiRule(runes); e != "" {
+			res.Errors = append(res.Errors, "bidi rule: "+e)
+		}
+	}
+	if res.valid() {
+		res.Output = out
+	}
+	return res
+}
+
+// precisWidth maps fullwidth and halfwidth codepoints to their decomposition.
+func precisWidth(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case wideToNarrow[r] != 0:
+			return wideToNarrow[r]
+		case r == 0xff9e, r == 0xff9f: // halfToFull has the spacing marks.
+			return r - 0xff9e + 0x3099
+		case halfToFull[r] != 0:
+			return halfToFull[r]
+		}
+		return r
+	}, s)
+}
+
+// precisSpaces maps non-ASCII spaces to U+0020 SPACE.
+func precisSpaces(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r > 0x7f {
+			if info, _ := unidata.Find(r); info.Category() == unidata.CatSpaceSeparator {
+				return ' '
+			}
+		}
+		return r
+	}, s)
+}
+
+// precisProperty gets the derived property value for a codepoint, with the
+// algorithm from RFC 8264 section 8.
+func precisProperty(r rune) string {
+	if p, ok := precisExceptions[r]; ok {
+		return p
+	}
+
+	info, ok := unidata.Find(r)
+	cat := info.Category()
+	if (!ok || cat == unidata.CatUnassigned) && !noncharacter.contains(r) {
+		return precisUnassigned
+	}
+	if r >= 0x21 && r <= 0x7e {
+		return precisPValid
+	}
+	if joinControl.contains(r) {
+		return precisContextJ
+	}
+	if oldHangulJamo.contains(r) || noncharacter.contains(r) || defaultIgnorable.contains(r) || cat == unidata.CatControl {
+		return precisDisallowed
+	}
+	if norm.NFKC.String(string(r)) != string(r) {
+		return precisFreePValid
+	}
+
+	switch cat {
+	case unidata.CatLowercaseLetter, unidata.CatUppercaseLetter, unidata.CatOtherLetter,
+		unidata.CatDecimalNumber, unidata.CatModifierLetter, unidata.CatNonspacingMark,
+		unidata.CatSpacingMark:
+		return precisPValid
+	case unidata.CatTitlecaseLetter, unidata.CatLetterNumber, unidata.CatOtherNumber,
+		unidata.CatEnclosingMark, unidata.CatSpaceSeparator,
+		unidata.CatMathSymbol, unidata.CatCurrencySymbol, unidata.CatModifierSymbol,
+		unidata.CatOtherSymbol, unidata.CatConnectorPunctuation, unidata.CatDashPunctuation,
+		unidata.CatOpenPunctuation, unidata.CatClosePunctuation, unidata.CatInitialPunctuation,
+		unidata.CatFinalPunctuation, unidata.CatOtherPunctuation:
+		return precisFreePValid
+	}
+	return precisDisallowed
+}
+
+// contextO checks the CONTEXTO rules from RFC 5892 appendix A.3 to A.9.
+func contextO(runes []rune, i int) bool {
+	script := func(j int) unidata.Script {
+		if j < 0 || j >= len(runes) {
+			return unidata.ScriptUnknown
+		}
+		return (unidata.Codepoint{Codepoint: runes[j]}).Script()
+	}
+	has := func(lo, hi rune) bool {
+		for _, r := range runes {
+			if r >= lo && r <= hi {
+				return true
+			}
+		}
+		return false
+	}
+
+	switch r := runes[i]; {
+	case r == 0x00b7: // MIDDLE DOT, only in "l·l" for Catalan.
+		return i > 0 && i+1 < len(runes) && runes[i-1] == 'l' && runes[i+1] == 'l'
+	case r == 0x0375: // GREEK LOWER NUMERAL SIGN
+		return script(i+1) == unidata.ScriptGreek
+	case r == 0x05f3, r == 0x05f4: // HEBREW PUNCTUATION GERESH and GERSHAYIM
+		return script(i-1) == unidata.ScriptHebrew
+	case r == 0x30fb: // KATAKANA MIDDLE DOT
+		for j := range runes {
+			if s := script(j); s == unidata.ScriptHiragana || s == unidata.ScriptKatakana || s == unidata.ScriptHan {
+				return true
+			}
+		}
+		return false
+	case r >= 0x0660 && r <= 0x0669: // ARABIC-INDIC DIGITs can't be mixed with EXTENDED ARABIC-INDIC DIGITs.
+		return !has(0x06f0, 0x06f9)
+	case r >= 0x06f0 && r <= 0x06f9:
+		return !has(0x0660, 0x0669)
+	}
+	return false
+}
@@ -0,0 +1,729 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"zgo.at/uni/v2/unidata"
+	"zgo.at/zstd/zstring"
+)
+
+// rangeSet is a set of codepoints, as a sorted list of non-overlapping and
+// non-adjacent ranges.
+type rangeSet [][2]rune
+
+const maxRune = 0x10ffff
+
+func newRangeSet(rng ...[2]rune) rangeSet {
+	s := make(rangeSet, 0, len(rng))
+	for _, r := range rng {
+		if r[0] > r[1] {
+			r[0], r[1] = r[1], r[0]
+		}
+		s = append(s, r)
+	}
+	return s.normalize()
+}
+
+func (s rangeSet) normalize() rangeSet {
+	if len(s) < 2 {
+		return s
+	}
+	sort.Slice(s, func(i, j int) bool { return s[i][0] < s[j][0] })
+	merged := s[:1]
+	for _, r := range s[1:] {
+		last := &merged[len(merged)-1]
+		if r[0] <= last[1]+1 {
+			if r[1] > last[1] {
+				last[1] = r[1]
+			}
+			continue
+		}
+		merged = append(merged, r)
+	}
+	return merged
+}
+
+func (s rangeSet) union(o rangeSet) rangeSet {
+	u := make(rangeSet, 0, len(s)+len(o))
+	return append(append(u, s...), o...).normalize()
+}
+
+func (s rangeSet) intersect(o rangeSet) rangeSet {
+	var (
+		in   = make(rangeSet, 0, len(s))
+		i, j int
+	)
+	for i < len(s) && j < len(o) {
+		lo, hi := s[i][0], s[i][1]
+		if o[j][0] > lo {
+			lo = o[j][0]
+		}
+		if o[j][1] < hi {
+			hi = o[j][1]
+		}
+		if lo <= hi {
+			in = append(in, [2]rune{lo, hi})
+		}
+		if s[i][1] < o[j][1] {
+			i++
+		} else {
+			j++
+		}
+	}
+	return in
+}
+
+func (s rangeSet) invert() rangeSet {
+	var (
+		inv  = make(rangeSet, 0, len(s)+1)
+		next rune
+	)
+	for _, r := range s {
+		if r[0] > next {
+			inv = append(inv, [2]rune{next, r[0] - 1})
+		}
+		next = r[1] + 1
+	}
+	if next <= maxRune {
+		inv = append(inv, [2]rune{next, maxRune})
+	}
+	return inv
+}
+
+func (s rangeSet) subtract(o rangeSet) rangeSet { return s.intersect(o.invert()) }
+
+// each calls fn for every codepoint in the set.
+func (s rangeSet) each(fn func(rune)) {
+	for _, r := range s {
+		for cp := r[0]; cp <= r[1]; cp++ {
+			fn(cp)
+		}
+	}
+}
+
+// contains reports if the codepoint is in the set.
+func (s rangeSet) contains(cp rune) bool {
+	i := sort.Search(len(s), func(i int) bool { return s[i][1] >= cp })
+	return i < len(s) && s[i][0] <= cp
+}
+
+// isQuery reports if the print argument is a query expression, rather than a
+// single block, category, range, etc.
+func isQuery(a string) bool {
+	a = strings.TrimSpace(a)
+	if a == "" {
+		return false
+	}
+	if a[0] == '(' || a[0] == '[' || zstring.HasPrefixes(a, `\p`, `\P`) {
+		return true
+	}
+	if zstring.HasPrefixes(strings.ToLower(a), "name:") {
+		return false // Names can contain "and", "not", etc.
+	}
+	for _, w := range strings.Fields(strings.ToLower(a)) {
+		if w == "and" || w == "or" || w == "not" {
+			// Block and category names such as "Halfwidth and Fullwidth
+			// Forms" also contain these words, so it's only a query if it's
+			// not a valid term on its own.
+			_, err := queryTerm(a)
+			return err != nil
+		}
+	}
+	return false
+}
+
+// selection gets the set of codepoints for a print argument; this can be a
+// query or anything else print accepts.
+func selection(a string) (rangeSet, error) {
+	if isQuery(a) {
+		return parseQuery(a)
+	}
+
+	s, err := queryTerm(strings.TrimSpace(a))
+	if err != nil && strings.Contains(a, "-") { // "0x41 - 0x5a"
+		if start, end, err2 := parseRange(strings.ToLower(a)); err2 == nil {
+			return newRangeSet([2]rune{start, end}), nil
+		}
+	}
+	return s, err
+}
+
+// parseQuery parses a query expression to a set of codepoints.
+//
+// The syntax is:
+//
+//	expr    = and { "or" and }
+//	and     = unary { "and" unary }
+//	unary   = "not" unary | "(" expr ")" | term
+//	term    = block:name | cat:name | script:name | prop:name | range
+//	          | \p{..} | \P{..} | [unicode set]
+//
+// "not" is relative to all codepoints, including unassigned ones.
+func parseQuery(q string) (rangeSet, error) {
+	toks, err := tokenizeQuery(q)
+	if err != nil {
+		return nil, err
+	}
+	p := queryParser{toks: toks}
+	s, err := p.expr()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.toks) {
+		return nil, fmt.Errorf("unexpected %q in query", p.toks[p.pos])
+	}
+	return s, nil
+}
+
+type queryParser struct {
+	toks []string
+	pos  int
+}
+
+func (p *queryParser) peek() string {
+	if p.pos >= len(p.toks) {
+		return ""
+	}
+	return strings.ToLower(p.toks[p.pos])
+}
+
+func (p *queryParser) expr() (rangeSet, error) {
+	s, err := p.and()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek() == "or" {
+		p.pos++
+		o, err := p.and()
+		if err != nil {
+			return nil, err
+		}
+		s = s.union(o)
+	}
+	return s, nil
+}
+
+func (p *queryParser) and() (rangeSet, error) {
+	s, err := p.unary()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek() == "and" {
+		p.pos++
+		o, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		s = s.intersect(o)
+	}
+	return s, nil
+}
+
+func (p *queryParser) unary() (rangeSet, error) {
+	switch p.peek() {
+	case "":
+		return nil, errors.New("unexpected end of query")
+	case "not":
+		p.pos++
+		s, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		return s.invert(), nil
+	case "(":
+		p.pos++
+		s, err := p.expr()
+		if err != nil {
+			return nil, err
+		}
+		if p.peek() != ")" {
+			return nil, errors.New("missing ) in query")
+		}
+		p.pos++
+		return s, nil
+	case ")", "and", "or":
+		return nil, fmt.Errorf("unexpected %q in query", p.toks[p.pos])
+	}
+
+	t := p.toks[p.pos]
+	p.pos++
+	return queryTerm(t)
+}
+
+// tokenizeQuery splits a query in to "(", ")", and terms. Terms end at
+// whitespace or a parenthesis, except inside quotes, \p{..}, or [..].
+func tokenizeQuery(q string) ([]string, error) {
+	var (
+		toks  []string
+		tok   strings.Builder
+		quote rune
+		depth int // [ and { nesting
+		prev  rune
+	)
+	flush := func() {
+		if tok.Len() > 0 {
+			toks = append(toks, tok.String())
+			tok.Reset()
+		}
+	}
+	for _, c := range q {
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			} else {
+				tok.WriteRune(c)
+			}
+		case prev == '\\':
+			tok.WriteRune(c)
+			c = 0 // Don't treat \\ as an escape for the next character.
+		case depth > 0:
+			if c == '[' || c == '{' {
+				depth++
+			} else if c == ']' || c == '}' {
+				depth--
+			}
+			tok.WriteRune(c)
+		case c == '\'' || c == '"':
+			quote = c
+		case c == '[' || c == '{':
+			depth++
+			tok.WriteRune(c)
+		case c == '(' || c == ')':
+			flush()
+			toks = append(toks, string(c))
+		case c == ' ' || c == '\t' || c == '\n':
+			flush()
+		default:
+			tok.WriteRune(c)
+		}
+		prev = c
+	}
+	if quote != 0 {
+		return nil, fmt.Errorf("unterminated %c in query", quote)
+	}
+	if depth > 0 {
+		return nil, errors.New("unterminated [ or { in query")
+	}
+	flush()
+	return toks, nil
+}
+
+// queryTerm gets the set for a single term.
+func queryTerm(t string) (rangeSet, error) {
+	switch {
+	case zstring.HasPrefixes(t, `\p{`, `\P{`):
+		if !strings.HasSuffix(t, "}") {
+			return nil, fmt.Errorf("missing } in %q", t)
+		}
+		s, err := queryProperty(t[3 : len(t)-1])
+		if err != nil {
+			return nil, err
+		}
+		if t[1] == 'P' {
+			s = s.invert()
+		}
+		return s, nil
+	case strings.HasPrefix(t, "["):
+		p := unicodeSet{s: []rune(t)}
+		s, err := p.set()
+		if err != nil {
+			return nil, fmt.Errorf("%q: %w", t, err)
+		}
+		if p.pos != len(p.s) {
+			return nil, fmt.Errorf("%q: unexpected %q", t, string(p.s[p.pos:]))
+		}
+		return s, nil
+	}
+
+	l := strings.ToLower(t)
+	if l == "all" {
+		return newRangeSet(unidata.Assigned()...), nil
+	}
+	if strings.HasPrefix(l, "utf8:") {
+		r, err := parseUTF8(l[5:])
+		if err != nil {
+			return nil, err
+		}
+		return newRangeSet([2]rune{r, r}), nil
+	}
+
+	switch {
+	case zstring.HasPrefixes(l, "block:", "b:"):
+		return queryGroup("block", l[strings.IndexByte(l, ':')+1:])
+	case zstring.HasPrefixes(l, "script:", "s:"):
+		return queryGroup("script", l[strings.IndexByte(l, ':')+1:])
+	case zstring.HasPrefixes(l, "category:", "cat:", "c:"):
+		return queryGroup("category", l[strings.IndexByte(l, ':')+1:])
+	case zstring.HasPrefixes(l, "property:", "prop:", "p:"):
+		return queryGroup("property", l[strings.IndexByte(l, ':')+1:])
+	}
+
+	if info, ok, err := findRef(t); ok {
+		if err != nil {
+			return nil, err
+		}
+		return newRangeSet([2]rune{info.Codepoint, info.Codepoint}), nil
+	}
+
+	// Block, category, or property without prefix.
+	var (
+		cat, catOk = unidata.FindCategory(l)
+		bl, blOk   = unidata.FindBlock(l)
+		p, pOk     = unidata.FindProperty(l)
+	)
+	switch {
+	case nbools(catOk, blOk, pOk) > 1:
+		return nil, fmt.Errorf("%q matched multiple options; prefix with 'block:', 'category:', or 'property:'", t)
+	case catOk:
+		return newRangeSet(cat.Ranges()...), nil
+	case blOk:
+		return newRangeSet(unidata.Blocks[bl].Range), nil
+	case pOk:
+		return newRangeSet(unidata.Properties[p].Ranges...), nil
+	}
+
+	// Codepoint or range; only "..", as "-" is ambiguous with names.
+	rng := strings.SplitN(l, "..", 2)
+	if len(rng) == 1 {
+		rng = append(rng, rng[0])
+	}
+	start, err := unidata.FromString(rng[0])
+	if err != nil {
+		return nil, fmt.Errorf("unknown query term: %q", t)
+	}
+	end, err := unidata.FromString(rng[1])
+	if err != nil {
+		return nil, fmt.Errorf("invalid codepoint: %s", errors.Unwrap(err))
+	}
+	if start.Codepoint > end.Codepoint {
+		return nil, fmt.Errorf("end of range %q is lower than start %q", rng[1], rng[0])
+	}
+	return newRangeSet([2]rune{start.Codepoint, end.Codepoint}), nil
+}
+
+// queryGroup gets the set for a block, category, script, or property.
+func queryGroup(kind, name string) (rangeSet, error) {
+	switch kind {
+	case "block":
+		if bl, ok := unidata.FindBlock(name); ok {
+			return newRangeSet(unidata.Blocks[bl].Range), nil
+		}
+	case "category":
+		if cat, ok := unidata.FindCategory(name); ok {
+			return newRangeSet(cat.Ranges()...), nil
+		}
+	case "script":
+		if sc, ok := unidata.FindScript(name); ok {
+			return newRangeSet(unidata.Scripts[sc].Ranges...), nil
+		}
+	case "property":
+		if p, ok := unidata.FindProperty(name); ok {
+			return newRangeSet(unidata.Properties[p].Ranges...), nil
+		}
+	}
+	return nil, fmt.Errorf("unknown or ambiguous %s: %q", kind, name)
+}
+
+// queryProperty gets the set for the contents of \p{..} or [:..:].
+//
+// This can be "key=value" (or "key:value") with the key as one of
+// General_Category/gc, Script/sc, Block/blk, or the property name. Without a
+// key it tries to find a category, script, or property, in that order; blocks
+// need to be explicitly prefixed (as Perl and PCRE do).
+func queryProperty(p string) (rangeSet, error) {
+	if i := strings.IndexAny(p, "=:"); i > -1 {
+		k, v := matchQuery(p[:i]), p[i+1:]
+		switch k {
+		case "gc", "generalcategory", "category", "cat":
+			return queryGroup("category", v)
+		case "sc", "script", "scx", "scriptextensions":
+			return queryGroup("script", v)
+		case "blk", "block":
+			return queryGroup("block", v)
+		}
+
+		// Binary properties: \p{White_Space=yes}
+		s, err := queryGroup("property", p[:i])
+		if err != nil {
+			return nil, err
+		}
+		switch matchQuery(v) {
+		case "y", "yes", "t", "true":
+			return s, nil
+		case "n", "no", "f", "false":
+			return s.invert(), nil
+		}
+		return nil, fmt.Errorf("invalid value for binary property %q: %q", p[:i], v)
+	}
+
+	switch matchQuery(p) {
+	case "any":
+		return newRangeSet([2]rune{0, maxRune}), nil
+	case "assigned":
+		return newRangeSet(unidata.Assigned()...), nil
+	case "ascii":
+		return newRangeSet([2]rune{0, 0x7f}), nil
+	}
+	if cat, ok := unidata.FindCategory(p); ok {
+		return newRangeSet(cat.Ranges()...), nil
+	}
+	if sc, ok := unidata.FindScript(p); ok {
+		return newRangeSet(unidata.Scripts[sc].Ranges...), nil
+	}
+	if pr, ok := unidata.FindProperty(p); ok {
+		return newRangeSet(unidata.Properties[pr].Ranges...), nil
+	}
+	if zstring.HasPrefixes(matchQuery(p), "in", "is") { // \p{InArrows}, \p{IsGreek}
+		if bl, ok := unidata.FindBlock(p[2:]); ok {
+			return newRangeSet(unidata.Blocks[bl].Range), nil
+		}
+		return queryProperty(p[2:])
+	}
+	return nil, fmt.Errorf("unknown property: %q", p)
+}
+
+func matchQuery(s string) string {
+	return strings.ToLower(strings.NewReplacer(" ", "", "_", "", "-", "").Replace(s))
+}
+
+// unicodeSet parses a UnicodeSet as described in UTS #35, for example:
+//
+//	[a-z]                 Range of characters.
+//	[[:Greek:]&[:Lu:]]    Intersection.
+//	[\p{L}-[a-z]]         Difference.
+//	[^\p{L}]              Complement.
+//
+// Operators are applied left to right; a set without operator is a union.
+type unicodeSet struct {
+	s   []rune
+	pos int
+}
+
+func (u *unicodeSet) peek() rune {
+	if u.pos >= len(u.s) {
+		return 0
+	}
+	return u.s[u.pos]
+}
+
+func (u *unicodeSet) set() (rangeSet, error) {
+	if u.peek() != '[' {
+		return nil, errors.New("expected [")
+	}
+	u.pos++
+
+	// [:Name:] and [:^Name:] on its own.
+	if u.peek() == ':' {
+		return u.posix()
+	}
+
+	negate := false
+	if u.peek() == '^' {
+		negate = true
+		u.pos++
+	}
+
+	var (
+		s  rangeSet
+		op rune
+	)
+	for {
+		c := u.peek()
+		switch c {
+		case 0:
+			return nil, errors.New("missing ]")
+		case ']':
+			u.pos++
+			if negate {
+				s = s.invert()
+			}
+			return s, nil
+		case ' ':
+			u.pos++
+			continue
+		case '&':
+			op = '&'
+			u.pos++
+			continue
+		case '-':
+			if len(s) > 0 && (u.pos+1 < len(u.s) && (u.s[u.pos+1] == '[' || u.s[u.pos+1] == '\\')) {
+				op = '-'
+				u.pos++
+				continue
+			}
+		}
+
+		var (
+			item rangeSet
+			err  error
+		)
+		switch {
+		case c == '[':
+			item, err = u.set()
+		case c == '\\' && u.pos+1 < len(u.s) && (u.s[u.pos+1] == 'p' || u.s[u.pos+1] == 'P'):
+			item, err = u.property()
+		default:
+			item, err = u.chars()
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		switch op {
+		case '&':
+			s = s.intersect(item)
+		case '-':
+			s = s.subtract(item)
+		default:
+			s = s.union(item)
+		}
+		op = 0
+	}
+}
+
+// [:Greek:], [:^Lu:]
+func (u *unicodeSet) posix() (rangeSet, error) {
+	u.pos++ // :
+	end := -1
+	for i := u.pos; i < len(u.s)-1; i++ {
+		if u.s[i] == ':' && u.s[i+1] == ']' {
+			end = i
+			break
+		}
+	}
+	if end == -1 {
+		return nil, errors.New("missing :]")
+	}
+
+	name := string(u.s[u.pos:end])
+	u.pos = end + 2
+	negate := strings.HasPrefix(name, "^")
+	s, err := queryProperty(strings.TrimPrefix(name, "^"))
+	if err != nil {
+		return nil, err
+	}
+	if negate {
+		s = s.invert()
+	}
+	return s, nil
+}
+
+// \p{..} and \P{..}
+func (u *unicodeSet) property() (rangeSet, error) {
+	negate := u.s[u.pos+1] == 'P'
+	u.pos += 2
+	if u.peek() != '{' {
+		return nil, errors.New(`need { after \p`)
+	}
+	end := -1
+	for i := u.pos; i < len(u.s); i++ {
+		if u.s[i] == '}' {
+			end = i
+			break
+		}
+	}
+	if end == -1 {
+		return nil, errors.New("missing }")
+	}
+	s, err := queryProperty(string(u.s[u.pos+1 : end]))
+	if err != nil {
+		return nil, err
+	}
+	u.pos = end + 1
+	if negate {
+		s = s.invert()
+	}
+	return s, nil
+}
+
+// A character or range of characters: a, a-z, a-z, \x{61}.
+func (u *unicodeSet) chars() (rangeSet, error) {
+	start, err := u.char()
+	if err != nil {
+		return nil, err
+	}
+	if u.peek() == '-' && u.pos+1 < len(u.s) && u.s[u.pos+1] != ']' && u.s[u.pos+1] != '[' {
+		u.pos++
+		end, err := u.char()
+		if err != nil {
+			return nil, err
+		}
+		if end < start {
+			return nil, fmt.Errorf("end of range %q is lower than start %q", end, start)
+		}
+		return rangeSet{{start, end}}, nil
+	}
+	return rangeSet{{start, start}}, nil
+}
+
+func (u *unicodeSet) char() (rune, error) {
+	c := u.peek()
+	if c == 0 {
+		return 0, errors.New("missing ]")
+	}
+	u.pos++
+	if c != '\\' {
+		return c, nil
+	}
+
+	c = u.peek()
+	if c == 0 {
+		return 0, errors.New(`trailing \`)
+	}
+	u.pos++
+	var n int
+	switch c {
+	default:
+		return c, nil
+	case 'n':
+		return '\n', nil
+	case 't':
+		return '\t', nil
+	case 'u':
+		n = 4
+	case 'U':
+		n = 8
+	case 'x':
+		n = 2
+	}
+
+	if u.peek() == '{' {
+		end := -1
+		for i := u.pos; i < len(u.s); i++ {
+			if u.s[i] == '}' {
+				end = i
+				break
+			}
+		}
+		if end == -1 {
+			return 0, errors.New("missing }")
+		}
+		h := string(u.s[u.pos+1 : end])
+		u.pos = end + 1
+		return parseHexRune(h)
+	}
+	if u.pos+n > len(u.s) {
+		return 0, fmt.Errorf(`need %d hex digits after \%c`, n, c)
+	}
+	h := string(u.s[u.pos : u.pos+n])
+	u.pos += n
+	return parseHexRune(h)
+}
+
+func parseHexRune(h string) (rune, error) {
+	i, err := strconv.ParseUint(h, 16, 32)
+	if err != nil || i > maxRune {
+		return 0, fmt.Errorf("invalid codepoint: %q", h)
+	}
+	r := rune(i)
+	if !utf8.ValidRune(r) && (r < 0xd800 || r > 0xdfff) {
+		return 0, fmt.Errorf("invalid codepoint: %q", h)
+	}
+	return r, nil
+}
@@ -0,0 +1,237 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"zgo.at/uni/v2/unidata"
+	"zgo.at/zli"
+)
+
+func stats(args []string, format string, raw bool, as printAs, files bool) error {
+	text := strings.Join(args, " ")
+	if files {
+		b := new(strings.Builder)
+		for _, a := range args {
+			t, err := os.ReadFile(a)
+			if err != nil {
+				return err
+			}
+			b.Write(t)
+		}
+		text = b.String()
+	}
+
+	var (
+		total int
+		cps   = make(map[rune]int)
+	)
+	for _, r := range text {
+		cps[r]++
+		total++
+	}
+	distinct := make([]unidata.Codepoint, 0, len(cps))
+	for r := range cps {
+		info, _ := unidata.Find(r)
+		distinct = append(distinct, info)
+	}
+	sort.Slice(distinct, func(i, j int) bool { return distinct[i].Codepoint < distinct[j].Codepoint })
+
+	// There's no sensible way to display the counts as a table, chart, or card,
+	// so show which codepoints are in the text.
+	if as == printAsTable || as == printAsTableCompact || as == printAsChart || as == printAsChartCompact ||
+		as == printAsCard || as == printAsCardCompact {
+		f, err := NewFormat(format, as, knownColumns...)
+		if err != nil {
+			return err
+		}
+		for _, info := range distinct {
+			f.Codepoint(info, raw)
+		}
+		f.Print(zli.Stdout)
+		return nil
+	}
+
+	var (
+		scripts = make(map[string]int)
+		blocks  = make(map[string]int)
+		cats    = make(map[unidata.Category]int)
+		props   = make(map[string]int)
+		unusual []unidata.Codepoint
+		rare    []unidata.Codepoint
+	)
+	for _, info := range distinct {
+		n := cps[info.Codepoint]
+		scripts[info.Script().String()] += n
+		blocks[info.Block().String()] += n
+		cats[info.Category()] += n
+		for _, p := range info.Properties() {
+			props[p.String()] += n
+		}
+	}
+
+	// Rare codepoints occur once, in a block that makes up 1% of the text or
+	// less; this finds a stray "é" in English text, but not every letter in
+	// a short text.
+	for _, info := range distinct {
+		switch {
+		case visMarker(0, info.Codepoint, 0) != "":
+			unusual = append(unusual, info)
+		case cps[info.Codepoint] == 1 && info.Codepoint > 0x7f && blocks[info.Block().String()]*100 <= total:
+			rare = append(rare, info)
+		}
+	}
+
+	percent := func(n int) string {
+		return strconv.FormatFloat(float64(n)/float64(total)*100, 'f', 1, 64) + "%"
+	}
+	counted := func(m map[string]int) []string {
+		names := make([]string, 0, len(m))
+		for k := range m {
+			names = append(names, k)
+		}
+		sort.Slice(names, func(i, j int) bool {
+			if m[names[i]] == m[names[j]] {
+				return names[i] < names[j]
+			}
+			return m[names[i]] > m[names[j]]
+		})
+		return names
+	}
+
+	// JSON is written as one object with a key for every section, and CSV/TSV
+	// as one table with a section column, so the output can be parsed as a
+	// single document.
+	var (
+		i      = 0
+		isJSON = as == printAsJSON || as == printAsJSONCompact
+		isCSV  = as == printAsCSV || as == printAsCSVCompact || as == printAsTSV || as == printAsTSVCompact
+		all    *Format
+	)
+	if isCSV {
+		var err error
+		all, err = NewFormat("%(section)  %(short)  %(cpoint)  %(char)  %(name)  %(count)  %(percent)", as,
+			"section", "short", "cpoint", "char", "name", "count", "percent")
+		if err != nil {
+			return err
+		}
+	}
+	section := func(name, format string, cols []string, lines []map[string]string) {
+		if len(lines) == 0 {
+			return
+		}
+		key := strings.ToLower(name)
+		switch {
+		case as == printAsList:
+			if i > 0 {
+				fmt.Fprintln(zli.Stdout)
+			}
+			fmt.Fprintf(zli.Stdout, "%s:\n", name)
+		case isJSON && i == 0:
+			fmt.Fprintf(zli.Stdout, "{%q: ", key)
+		case isJSON:
+			fmt.Fprintf(zli.Stdout, ", %q: ", key)
+		}
+		i++
+
+		if isCSV {
+			for _, l := range lines {
+				l["section"] = key
+				all.Line(l)
+			}
+			return
+		}
+		if as == printAsNDJSON || as == printAsNDJSONCompact {
+			format, cols = "%(section)  "+format, append([]string{"section"}, cols...)
+			for _, l := range lines {
+				l["section"] = key
+			}
+		}
+
+		f, err := NewFormat(format, as, cols...)
+		zli.F(err)
+		for _, l := range lines {
+			f.Line(l)
+		}
+		if isJSON {
+			buf := new(strings.Builder)
+			f.Print(buf)
+			fmt.Fprint(zli.Stdout, strings.TrimRight(buf.String(), "\n"))
+			return
+		}
+		f.Print(zli.Stdout)
+	}
+
+	section("Summary", "%(name l:auto)  %(count r:auto)", []string{"name", "count"}, []map[string]string{
+		{"name": "codepoints", "count": strconv.Itoa(total)},
+		{"name": "distinct", "count": strconv.Itoa(len(distinct))},
+	})
+
+	countFmt, countCols := "%(name l:auto)  %(count r:auto)  %(percent r:auto)", []string{"name", "count", "percent"}
+	for _, s := range []struct {
+		name string
+		m    map[string]int
+	}{{"Scripts", scripts}, {"Blocks", blocks}} {
+		var lines []map[string]string
+		for _, k := range counted(s.m) {
+			lines = append(lines, map[string]string{"name": k, "count": strconv.Itoa(s.m[k]), "percent": percent(s.m[k])})
+		}
+		section(s.name, countFmt, countCols, lines)
+	}
+
+	{
+		order := make([]unidata.Category, 0, len(cats))
+		for k := range cats {
+			order = append(order, k)
+		}
+		sort.Slice(order, func(i, j int) bool {
+			if cats[order[i]] == cats[order[j]] {
+				return order[i] < order[j]
+			}
+			return cats[order[i]] > cats[order[j]]
+		})
+		var lines []map[string]string
+		for _, c := range order {
+			lines = append(lines, map[string]string{"short": unidata.Categories[c].ShortName,
+				"name": c.String(), "count": strconv.Itoa(cats[c]), "percent": percent(cats[c])})
+		}
+		section("Categories", "%(short l:auto)  %(name l:auto)  %(count r:auto)  %(percent r:auto)",
+			[]string{"short", "name", "count", "percent"}, lines)
+	}
+
+	{
+		var lines []map[string]string
+		for _, k := range counted(props) {
+			lines = append(lines, map[string]string{"name": k, "count": strconv.Itoa(props[k]), "percent": percent(props[k])})
+		}
+		section("Properties", countFmt, countCols, lines)
+	}
+
+	cpFmt, cpCols := "%(cpoint l:auto)  %(char l:auto)  %(count r:auto)  %(name)", []string{"cpoint", "char", "count", "name"}
+	for _, s := range []struct {
+		name string
+		cps  []unidata.Codepoint
+	}{{"Unusual", unusual}, {"Rare", rare}} {
+		var lines []map[string]string
+		for _, info := range s.cps {
+			char := info.Display()
+			if m := visMarker(0, info.Codepoint, 0); m != "" {
+				char = "⟨" + m + "⟩"
+			}
+			lines = append(lines, map[string]string{"cpoint": info.FormatCodepoint(), "char": char,
+				"count": strconv.Itoa(cps[info.Codepoint]), "name": info.Name()})
+		}
+		section(s.name, cpFmt, cpCols, lines)
+	}
+
+	if all != nil {
+		all.Print(zli.Stdout)
+	}
+	if isJSON && i > 0 {
+		fmt.Fprintln(zli.Stdout, "}")
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"zgo.at/uni/v2/unidata"
+	"zgo.at/zli"
+)
+
+func style(args []string, quiet bool) error {
+	if len(args) == 0 {
+		return fmt.Errorf("style: need a style; one of: plain, all, %s", strings.Join(unidata.Styles(), ", "))
+	}
+	st, err := match(args[0], append([]string{"plain", "all"}, unidata.Styles()...)...)
+	if err != nil {
+		return fmt.Errorf("style: unknown style %q; one of: plain, all, %s", args[0], strings.Join(unidata.Styles(), ", "))
+	}
+	args, err = zli.InputOrArgs(args[1:], "", quiet)
+	if err != nil {
+		return err
+	}
+	text := strings.Join(args, " ")
+
+	switch st {
+	case "plain":
+		fmt.Fprintln(zli.Stdout, unidata.Unstyle(text))
+	case "all":
+		styles := unidata.Styles()
+		w := 0
+		for _, s := range styles {
+			if len(s) > w {
+				w = len(s)
+			}
+		}
+		for _, s := range styles {
+			t, _ := unidata.Style(s, text)
+			fmt.Fprintf(zli.Stdout, "%-*s  %s\n", w, s, t)
+		}
+	default:
+		t, _ := unidata.Style(st, text)
+		fmt.Fprintln(zli.Stdout, t)
+	}
+	return nil
+}
@@ -0,0 +1,147 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/template"
+
+	"zgo.at/uni/v2/unidata"
+	"zgo.at/zli"
+)
+
+// The -template flag; "" to use -format.
+var templateText = ""
+
+// tmplCodepoint is what a -template gets for every codepoint.
+type tmplCodepoint struct {
+	unidata.Codepoint
+	Rune rune   // Same as .Codepoint.Codepoint.
+	Char string // Character to display, or the codepoint as-is with -raw.
+}
+
+// tmplEmoji is what a -template gets for every emoji.
+type tmplEmoji struct {
+	unidata.Emoji
+	Char string // The emoji, with ZWJ and variation selectors.
+}
+
+// newTemplate parses the -template flag; templates starting with @ are read
+// from a file.
+func newTemplate(text string) (*template.Template, error) {
+	if strings.HasPrefix(text, "@") {
+		b, err := os.ReadFile(text[1:])
+		if err != nil {
+			return nil, fmt.Errorf("-template flag: %w", err)
+		}
+		text = string(b)
+	}
+
+	t, err := template.New("").Option("missingkey=error").Funcs(tmplFuncs).Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("-template flag: %w", err)
+	}
+	return t, nil
+}
+
+// execTemplate adds a line with the output of the template.
+func (f *Format) execTemplate(data interface{}) {
+	buf := new(bytes.Buffer)
+	err := f.tmpl.Execute(buf, data)
+	if err != nil {
+		// The template is already parsed, so this is usually calling a
+		// function with the wrong type.
+		zli.Fatalf("-template flag: %s", err)
+	}
+	f.lines = append(f.lines, []string{strings.TrimSuffix(buf.String(), "\n")})
+}
+
+func (f *Format) printTemplate(out io.Writer) {
+	for lineno, l := range f.lines {
+		if h, ok := f.groups[lineno]; ok {
+			if lineno > 0 && f.as != printAsListCompact {
+				out.Write([]byte("\n"))
+			}
+			out.Write([]byte(h + "\n"))
+		}
+		out.Write([]byte(l[0] + "\n"))
+	}
+}
+
+var tmplFuncs = template.FuncMap{
+	"cpoint": func(v interface{}) (string, error) {
+		return tmplEach(v, " ", unidata.Codepoint.FormatCodepoint)
+	},
+	"json": func(v interface{}) (string, error) { return tmplEach(v, "", unidata.Codepoint.JSON) },
+	"xml":  func(v interface{}) (string, error) { return tmplEach(v, "", unidata.Codepoint.XML) },
+	"html": func(v interface{}) (string, error) { return tmplEach(v, "", unidata.Codepoint.HTML) },
+	"utf8": func(v interface{}) ([]byte, error) {
+		return tmplBytes(v, unidata.Codepoint.UTF8)
+	},
+	"utf16be": func(v interface{}) ([]byte, error) {
+		return tmplBytes(v, func(c unidata.Codepoint) []byte { return c.UTF16(true) })
+	},
+	"utf16le": func(v interface{}) ([]byte, error) {
+		return tmplBytes(v, func(c unidata.Codepoint) []byte { return c.UTF16(false) })
+	},
+	"bytes": func(sep, pre string, b []byte) string {
+		s := make([]string, 0, len(b))
+		for _, c := range b {
+			s = append(s, fmt.Sprintf("%s%02x", pre, c))
+		}
+		return strings.Join(s, sep)
+	},
+	"join":  func(sep string, s []string) string { return strings.Join(s, sep) },
+	"lower": strings.ToLower,
+	"upper": strings.ToUpper,
+}
+
+// tmplRunes gets the codepoints for a value passed to a template function.
+func tmplRunes(v interface{}) ([]rune, error) {
+	switch vv := v.(type) {
+	case rune:
+		return []rune{vv}, nil
+	case int:
+		return []rune{rune(vv)}, nil
+	case string:
+		return []rune(vv), nil
+	case []rune:
+		return vv, nil
+	case unidata.Codepoint:
+		return []rune{vv.Codepoint}, nil
+	case tmplCodepoint:
+		return []rune{vv.Codepoint.Codepoint}, nil
+	case unidata.Emoji:
+		return []rune(vv.String()), nil
+	case tmplEmoji:
+		return []rune(vv.Emoji.String()), nil
+	default:
+		return nil, fmt.Errorf("can't get codepoints from a %T", v)
+	}
+}
+
+func tmplEach(v interface{}, sep string, fun func(unidata.Codepoint) string) (string, error) {
+	runes, err := tmplRunes(v)
+	if err != nil {
+		return "", err
+	}
+	s := make([]string, 0, len(runes))
+	for _, r := range runes {
+		s = append(s, fun(unidata.Codepoint{Codepoint: r}))
+	}
+	return strings.Join(s, sep), nil
+}
+
+func tmplBytes(v interface{}, fun func(unidata.Codepoint) []byte) ([]byte, error) {
+	runes, err := tmplRunes(v)
+	if err != nil {
+		return nil, err
+	}
+	b := make([]byte, 0, len(runes)*2)
+	for _, r := range runes {
+		b = append(b, fun(unidata.Codepoint{Codepoint: r})...)
+	}
+	return b, nil
+}
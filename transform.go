@@ -0,0 +1,160 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"golang.org/x/text/unicode/norm"
+	"zgo.at/zli"
+)
+
+var transforms = []string{"narrow", "wide", "halfwidth", "fullwidth", "hiragana", "katakana"}
+
+var (
+	// Fullwidth forms (decomposition type <wide>) and the reverse.
+	wideToNarrow = make(map[rune]rune)
+	narrowToWide = make(map[rune]rune)
+
+	// Halfwidth forms (decomposition type <narrow>) and the reverse.
+	halfToFull = make(map[rune]rune)
+	fullToHalf = make(map[rune]rune)
+)
+
+func init() {
+	add := func(from, to map[rune]rune, r rune) {
+		d := []rune(norm.NFKC.String(string(r)))
+		if len(d) != 1 {
+			return
+		}
+		from[r], to[d[0]] = d[0], r
+	}
+
+	add(wideToNarrow, narrowToWide, 0x3000)
+	for r := rune(0xff01); r <= 0xff60; r++ {
+		add(wideToNarrow, narrowToWide, r)
+	}
+	for r := rune(0xffe0); r <= 0xffe6; r++ {
+		add(wideToNarrow, narrowToWide, r)
+	}
+	// U+00AF MACRON has a compatibility decomposition itself.
+	wideToNarrow[0xffe3], narrowToWide[0xaf] = 0xaf, 0xffe3
+
+	// Only kana, Japanese punctuation, and Hangul are converted to halfwidth;
+	// U+FFE8..U+FFEE are box drawing and arrows, which are only converted to
+	// fullwidth.
+	for r := rune(0xff61); r <= 0xffdc; r++ {
+		add(halfToFull, fullToHalf, r)
+	}
+	for r := rune(0xffe8); r <= 0xffee; r++ {
+		add(halfToFull, make(map[rune]rune), r)
+	}
+	// The halfwidth voiced sound marks decompose to the combining marks, but
+	// on their own they should be the spacing marks.
+	halfToFull[0xff9e], fullToHalf[0x309b] = 0x309b, 0xff9e
+	halfToFull[0xff9f], fullToHalf[0x309c] = 0x309c, 0xff9f
+}
+
+func transform(args []string, quiet bool) error {
+	if len(args) == 0 {
+		return fmt.Errorf("transform: need a transform; one of: %s", strings.Join(transforms, ", "))
+	}
+	set := make(map[string]bool)
+	for _, t := range strings.Split(args[0], ",") {
+		t = strings.TrimSpace(t)
+		if t == "" {
+			continue
+		}
+		m, err := match(t, transforms...)
+		if err != nil {
+			return fmt.Errorf("transform: unknown transform %q; one of: %s", t, strings.Join(transforms, ", "))
+		}
+		set[m] = true
+	}
+	for _, p := range [][2]string{{"narrow", "wide"}, {"halfwidth", "fullwidth"}, {"hiragana", "katakana"}} {
+		if set[p[0]] && set[p[1]] {
+			return fmt.Errorf("transform: can't use both %s and %s", p[0], p[1])
+		}
+	}
+
+	args, err := zli.InputOrArgs(args[1:], "", quiet)
+	if err != nil {
+		return err
+	}
+
+	ch := make(changes)
+	fmt.Fprintln(zli.Stdout, transformText(strings.Join(args, " "), set, ch))
+	if !quiet {
+		ch.summary(zli.Stderr, "transform")
+	}
+	return nil
+}
+
+// transformText applies the transforms in set to s, recording every changed
+// codepoint in ch.
+//
+// Halfwidth katakana are converted to fullwidth before converting to hiragana,
+// and hiragana to katakana before converting to halfwidth, so these can be
+// combined.
+func transformText(s string, set map[string]bool, ch changes) string {
+	var (
+		b     = new(strings.Builder)
+		runes = []rune(s)
+	)
+	b.Grow(len(s))
+	for i := 0; i < len(runes); i++ {
+		var (
+			r    = runes[i]
+			from = string(r)
+			to   string
+		)
+		if r < 0x80 && !set["wide"] { // Common case.
+			b.WriteRune(r)
+			continue
+		}
+
+		switch {
+		case set["narrow"] && wideToNarrow[r] != 0:
+			r = wideToNarrow[r]
+		case set["wide"] && narrowToWide[r] != 0:
+			r = narrowToWide[r]
+		case set["fullwidth"] && halfToFull[r] != 0:
+			r = halfToFull[r]
+			// Compose the voiced sound marks with the previous katakana.
+			if i+1 < len(runes) && (runes[i+1] == 0xff9e || runes[i+1] == 0xff9f) {
+				if c := []rune(norm.NFC.String(string(r) + string(runes[i+1]-0xff9e+0x3099))); len(c) == 1 {
+					r = c[0]
+					from += string(runes[i+1])
+					i++
+				}
+			}
+		}
+
+		switch {
+		case set["hiragana"] && ((r >= 0x30a1 && r <= 0x30f6) || r == 0x30fd || r == 0x30fe):
+			r -= 0x60
+		case set["katakana"] && ((r >= 0x3041 && r <= 0x3096) || r == 0x309d || r == 0x309e):
+			r += 0x60
+		}
+
+		to = string(r)
+		if set["halfwidth"] {
+			// Decompose the voiced sound marks, which don't have a precomposed
+			// halfwidth form.
+			d := []rune(norm.NFD.String(to))
+			if h := fullToHalf[d[0]]; h != 0 {
+				switch {
+				case len(d) == 1:
+					to = string(h)
+				case len(d) == 2 && (d[1] == 0x3099 || d[1] == 0x309a):
+					to = string(h) + string(d[1]-0x3099+0xff9e)
+				}
+			}
+		}
+
+		if to != from {
+			ch.add("replaced", from, to)
+		}
+		b.WriteString(to)
+	}
+	return b.String()
+}
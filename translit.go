@@ -0,0 +1,389 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+	"zgo.at/uni/v2/unidata"
+	"zgo.at/zli"
+)
+
+var translitSchemes = []string{"ascii", "iso9", "elot", "hepburn", "pinyin"}
+
+func translit(args []string, scheme string) error {
+	s, err := match(scheme, translitSchemes...)
+	if err != nil {
+		return fmt.Errorf("unknown value for -scheme: %q; one of: %s", scheme, strings.Join(translitSchemes, ", "))
+	}
+
+	text := strings.Join(args, " ")
+	switch s {
+	case "ascii":
+		text = toASCII(text, "?")
+	case "iso9":
+		text = iso9(text)
+	case "elot":
+		text = elot(text)
+	case "hepburn":
+		text = hepburn(text)
+	case "pinyin":
+		text = toPinyin(text)
+	}
+	fmt.Fprintln(zli.Stdout, text)
+	return nil
+}
+
+// Symbols that aren't in the Latin-ASCII transform.
+var asciiSymbols = map[rune]string{
+	'€': "EUR", '£': "GBP", '¥': "JPY", '₹': "INR", '₽': "RUB", '₩': "KRW",
+	'¢': "c", '§': "S", '¶': "P", '°': "deg", '·': ".", '•': "*", '…': "...",
+}
+
+// toASCII transliterates all scripts uni knows about to Latin and then folds
+// to ASCII; codepoints that can't be represented in ASCII are replaced with
+// unknown.
+func toASCII(s, unknown string) string {
+	s = toPinyin(hepburn(elot(iso9(s))))
+
+	b := new(strings.Builder)
+	b.Grow(len(s))
+	for _, r := range s {
+		if r < 0x80 {
+			b.WriteRune(r)
+			continue
+		}
+		b.WriteString(foldASCII(r, unknown))
+	}
+	return b.String()
+}
+
+func foldASCII(r rune, unknown string) string {
+	if a, ok := asciiSymbols[r]; ok {
+		return a
+	}
+	if a := (unidata.Codepoint{Codepoint: r}).LatinASCII(); a != "" {
+		return a
+	}
+
+	d := norm.NFKD.String(string(r))
+	a := make([]byte, 0, len(d))
+	for _, c := range d {
+		switch {
+		case c < 0x80:
+			a = append(a, byte(c))
+		case unicode.Is(unicode.Mn, c):
+		default:
+			if l := (unidata.Codepoint{Codepoint: c}).LatinASCII(); l != "" {
+				a = append(a, l...)
+				continue
+			}
+			return unknown
+		}
+	}
+	if len(a) == 0 {
+		return unknown
+	}
+	return string(a)
+}
+
+// asciiColumn gets the value for the %(ascii) column.
+func asciiColumn(r rune) string {
+	if r < 0x80 {
+		if r < 0x20 || r == 0x7f {
+			return ""
+		}
+		return string(r)
+	}
+	return strings.TrimSpace(toASCII(string(r), ""))
+}
+
+// ISO 9:1995 transliteration of Cyrillic; the lower case letters are derived
+// from this.
+var iso9Table = map[rune]string{
+	'А': "A", 'Б': "B", 'В': "V", 'Г': "G", 'Ґ': "G̀", 'Д': "D", 'Ѓ': "Ǵ", 'Ђ': "Đ",
+	'Е': "E", 'Ё': "Ë", 'Є': "Ê", 'Ж': "Ž", 'З': "Z", 'Ѕ': "Ẑ", 'И': "I", 'І': "Ì",
+	'Ї': "Ï", 'Й': "J", 'Ј': "J̌", 'К': "K", 'Л': "L", 'Љ': "L̂", 'М': "M", 'Н': "N",
+	'Њ': "N̂", 'О': "O", 'П': "P", 'Р': "R", 'С': "S", 'Т': "T", 'Ќ': "Ḱ", 'Ћ': "Ć",
+	'У': "U", 'Ў': "Ǔ", 'Ф': "F", 'Х': "H", 'Ц': "C", 'Ч': "Č", 'Џ': "D̂", 'Ш': "Š",
+	'Щ': "Ŝ", 'Ъ': "ʺ", 'Ы': "Y", 'Ь': "ʹ", 'Э': "È", 'Ю': "Û", 'Я': "Â", 'Ѣ': "Ě",
+	'Ѫ': "Ǎ", 'Ѳ': "F̀", 'Ѵ': "Ỳ", 'Ӑ': "Ă", 'Ӓ': "Ä", 'Ӕ': "Æ", 'Ӗ': "Ĕ", 'Ә': "A̋",
+	'Ӝ': "Z̄", 'Ӟ': "Z̈", 'Ӣ': "Ī", 'Ӥ': "Î", 'Ӧ': "Ö", 'Ө': "Ô", 'Ӯ': "Ū", 'Ӱ': "Ü",
+	'Ӳ': "Ű", 'Ӵ': "C̈", 'Ӹ': "Ÿ", 'Ҕ': "Ğ", 'Ҙ': "Ź", 'Ҝ': "K̂", 'Ҡ': "K̄", 'Ң': "Ṇ",
+	'Ҥ': "Ṅ", 'Ҫ': "Ș", 'Ү': "Ù", 'Ҳ': "H̦", 'Ҷ': "Ç", 'Ҹ': "C̄", 'Һ': "Ḥ", 'Ӏ': "‡",
+}
+
+// iso9 transliterates Cyrillic with ISO 9:1995; every Cyrillic letter has
+// exactly one Latin equivalent, so this is reversible.
+func iso9(s string) string {
+	b := new(strings.Builder)
+	b.Grow(len(s))
+	for _, r := range s {
+		if l, ok := iso9Table[r]; ok {
+			b.WriteString(l)
+		} else if l, ok := iso9Table[unicode.ToUpper(r)]; ok && unicode.IsLower(r) {
+			b.WriteString(strings.ToLower(l))
+		} else {
+			b.WriteRune(r)
+		}
+	}
+	return norm.NFC.String(b.String())
+}
+
+// ELOT 743 transcription of Greek (also used by the UN); this is phonetic and
+// depends on the surrounding letters.
+var (
+	elotTable = map[rune]string{
+		'α': "a", 'β': "v", 'γ': "g", 'δ': "d", 'ε': "e", 'ζ': "z", 'η': "i", 'θ': "th",
+		'ι': "i", 'κ': "k", 'λ': "l", 'μ': "m", 'ν': "n", 'ξ': "x", 'ο': "o", 'π': "p",
+		'ρ': "r", 'σ': "s", 'ς': "s", 'τ': "t", 'υ': "y", 'φ': "f", 'χ': "ch", 'ψ': "ps",
+		'ω': "o",
+	}
+	// αυ, ευ, and ηυ are "f" before these, and "v" otherwise.
+	elotVoiceless = "θκξπστφχψ"
+)
+
+func elot(s string) string {
+	type letter struct {
+		base        rune // Lower case, without accents.
+		upper, word bool // Upper case; end of word.
+		marks       string
+	}
+
+	var (
+		b    = new(strings.Builder)
+		text = []rune(norm.NFD.String(s))
+	)
+	b.Grow(len(s))
+	for i := 0; i < len(text); i++ {
+		r := text[i]
+		lr := unicode.ToLower(r)
+		if _, ok := elotTable[lr]; !ok {
+			b.WriteRune(r)
+			continue
+		}
+
+		// Collect the Greek word, so we can look at the next and previous
+		// letters.
+		var word []letter
+		for ; i < len(text); i++ {
+			r := text[i]
+			if unicode.Is(unicode.Mn, r) && len(word) > 0 {
+				word[len(word)-1].marks += string(r)
+				continue
+			}
+			if _, ok := elotTable[unicode.ToLower(r)]; !ok {
+				break
+			}
+			word = append(word, letter{base: unicode.ToLower(r), upper: unicode.IsUpper(r)})
+		}
+		i--
+
+		allUpper := len(word) > 1
+		for _, l := range word {
+			allUpper = allUpper && l.upper
+		}
+		at := func(j int) rune {
+			if j < 0 || j >= len(word) {
+				return 0
+			}
+			return word[j].base
+		}
+
+		for j := 0; j < len(word); j++ {
+			var (
+				l     = word[j]
+				next  = at(j + 1)
+				tr    = elotTable[l.base]
+				marks = l.marks
+			)
+			switch {
+			case (l.base == 'α' || l.base == 'ε' || l.base == 'η') && next == 'υ' && !strings.Contains(word[j+1].marks, "̈"):
+				v := "v"
+				if n := at(j + 2); n == 0 || strings.ContainsRune(elotVoiceless, n) {
+					v = "f"
+				}
+				tr = elotTable[l.base] + v
+				marks += word[j+1].marks
+				j++
+			case l.base == 'ο' && next == 'υ':
+				tr, marks = "ou", ""
+				if word[j+1].marks != "" || l.marks != "" {
+					tr = "o" + norm.NFC.String("u"+l.marks+word[j+1].marks)
+				}
+				j++
+			case l.base == 'μ' && next == 'π':
+				tr = "mp"
+				if j == 0 || j+2 == len(word) {
+					tr = "b"
+				}
+				j++
+			case l.base == 'ν' && next == 'τ':
+				tr = "nt"
+				if j == 0 {
+					tr = "d"
+				}
+				j++
+			case l.base == 'γ' && (next == 'γ' || next == 'ξ' || next == 'χ'):
+				tr = "n"
+			case l.base == 'υ' && j > 0 && strings.ContainsRune("αεου", at(j-1)):
+				tr = "i" // αυ etc. are handled above; this is υι.
+				if at(j-1) != 'ο' {
+					tr = "y"
+				}
+			}
+
+			if marks != "" {
+				// Put accents on the first vowel.
+				k := strings.IndexAny(tr, "aeiouy")
+				if k > -1 {
+					tr = tr[:k+1] + marks + tr[k+1:]
+				}
+			}
+			if l.upper {
+				if allUpper {
+					tr = strings.ToUpper(tr)
+				} else {
+					first, n := utf8.DecodeRuneInString(tr)
+					tr = string(unicode.ToUpper(first)) + tr[n:]
+				}
+			}
+			b.WriteString(tr)
+		}
+	}
+	return norm.NFC.String(b.String())
+}
+
+// Modified Hepburn romanization of hiragana; katakana is converted to
+// hiragana first.
+var hepburnTable = map[rune]string{
+	'あ': "a", 'い': "i", 'う': "u", 'え': "e", 'お': "o",
+	'か': "ka", 'き': "ki", 'く': "ku", 'け': "ke", 'こ': "ko",
+	'が': "ga", 'ぎ': "gi", 'ぐ': "gu", 'げ': "ge", 'ご': "go",
+	'さ': "sa", 'し': "shi", 'す': "su", 'せ': "se", 'そ': "so",
+	'ざ': "za", 'じ': "ji", 'ず': "zu", 'ぜ': "ze", 'ぞ': "zo",
+	'た': "ta", 'ち': "chi", 'つ': "tsu", 'て': "te", 'と': "to",
+	'だ': "da", 'ぢ': "ji", 'づ': "zu", 'で': "de", 'ど': "do",
+	'な': "na", 'に': "ni", 'ぬ': "nu", 'ね': "ne", 'の': "no",
+	'は': "ha", 'ひ': "hi", 'ふ': "fu", 'へ': "he", 'ほ': "ho",
+	'ば': "ba", 'び': "bi", 'ぶ': "bu", 'べ': "be", 'ぼ': "bo",
+	'ぱ': "pa", 'ぴ': "pi", 'ぷ': "pu", 'ぺ': "pe", 'ぽ': "po",
+	'ま': "ma", 'み': "mi", 'む': "mu", 'め': "me", 'も': "mo",
+	'や': "ya", 'ゆ': "yu", 'よ': "yo",
+	'ら': "ra", 'り': "ri", 'る': "ru", 'れ': "re", 'ろ': "ro",
+	'わ': "wa", 'ゐ': "i", 'ゑ': "e", 'を': "o", 'ん': "n", 'ゔ': "vu",
+	'ぁ': "a", 'ぃ': "i", 'ぅ': "u", 'ぇ': "e", 'ぉ': "o",
+	'ゃ': "ya", 'ゅ': "yu", 'ょ': "yo", 'ゎ': "wa", 'ゕ': "ka", 'ゖ': "ke",
+	'ヷ': "va", 'ヸ': "vi", 'ヹ': "ve", 'ヺ': "vo",
+}
+
+func toHiragana(r rune) rune {
+	if r >= 'ァ' && r <= 'ヶ' {
+		return r - 0x60
+	}
+	return r
+}
+
+func hepburn(s string) string {
+	var (
+		b    = new(strings.Builder)
+		text = []rune(s)
+		tsu  = false // Previous was small tsu (sokuon).
+	)
+	b.Grow(len(s))
+	for i := 0; i < len(text); i++ {
+		r := toHiragana(text[i])
+		var next rune
+		if i+1 < len(text) {
+			next = toHiragana(text[i+1])
+		}
+
+		switch {
+		case r == 'っ':
+			tsu = true
+			continue
+		case r == 'ー':
+			// Long vowel: add a macron to the previous vowel.
+			b.WriteString("̄")
+			continue
+		}
+
+		tr, ok := hepburnTable[r]
+		if !ok {
+			if tsu {
+				b.WriteRune(text[i-1])
+				tsu = false
+			}
+			b.WriteRune(text[i])
+			continue
+		}
+
+		switch {
+		// Yōon: きゃ → kya, しゃ → sha.
+		case strings.HasSuffix(tr, "i") && len(tr) > 1 && (next == 'ゃ' || next == 'ゅ' || next == 'ょ'):
+			tr = tr[:len(tr)-1]
+			if tr == "sh" || tr == "ch" || tr == "j" {
+				tr += hepburnTable[next][1:]
+			} else {
+				tr += hepburnTable[next]
+			}
+			i++
+		// Small vowels in loanwords: ファ → fa, ティ → ti, ウィ → wi.
+		case next == 'ぁ' || next == 'ぃ' || next == 'ぅ' || next == 'ぇ' || next == 'ぉ':
+			tr = tr[:len(tr)-1]
+			if tr == "" {
+				tr = "w"
+			}
+			tr += hepburnTable[next]
+			i++
+		case r == 'ん':
+			if n := hepburnTable[next]; n != "" && strings.ContainsAny(n[:1], "aeiouy") {
+				tr = "n'"
+			}
+		}
+
+		if tsu {
+			if strings.HasPrefix(tr, "ch") {
+				tr = "t" + tr
+			} else if !strings.ContainsAny(tr[:1], "aeiou") {
+				tr = tr[:1] + tr
+			}
+			tsu = false
+		}
+		b.WriteString(tr)
+	}
+	return norm.NFC.String(b.String())
+}
+
+// toPinyin converts CJK ideographs to pinyin, with a space between every
+// syllable.
+func toPinyin(s string) string {
+	var (
+		b     = new(strings.Builder)
+		space = false // Add space before the next letter.
+	)
+	b.Grow(len(s))
+	for _, r := range s {
+		p := (unidata.Codepoint{Codepoint: r}).Pinyin()
+		if p == "" {
+			if space && (unicode.IsLetter(r) || unicode.IsDigit(r)) {
+				b.WriteByte(' ')
+			}
+			space = false
+			b.WriteRune(r)
+			continue
+		}
+
+		if b.Len() > 0 {
+			last, _ := utf8.DecodeLastRuneInString(b.String())
+			if unicode.IsLetter(last) || unicode.IsDigit(last) {
+				b.WriteByte(' ')
+			}
+		}
+		b.WriteString(p)
+		space = true
+	}
+	return b.String()
+}
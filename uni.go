@@ -22,6 +22,67 @@ var (
 	version      = "git"
 )
 
+// errSilent is wrapped by errors that don't print anything but exit with 1,
+// as the command already printed the details (e.g. lint findings).
+var errSilent = errors.New("exit 1")
+
+// inputMode is how a command reads its input.
+type inputMode uint8
+
+const (
+	inputWords inputMode = iota // Arguments, or stdin split on whitespace.
+	inputLines                  // Arguments, or every line from stdin.
+	inputSelf                   // The command reads the arguments or stdin itself.
+)
+
+// command is a command, with the prefixes that run it if they're ambiguous
+// (e.g. "p" is also a prefix of "precis", but has always run "print").
+type command struct {
+	name      string
+	shortcuts []string
+	template  bool // Supports -template.
+	input     inputMode
+}
+
+var commands = []command{
+	{name: "list", shortcuts: []string{"l", "li"}, input: inputSelf},
+	{name: "identify", shortcuts: []string{"i", "id", "ide", "iden"}, template: true},
+	{name: "print", shortcuts: []string{"p", "pr"}, template: true},
+	{name: "search", shortcuts: []string{"s"}, template: true},
+	{name: "emoji", shortcuts: []string{"e"}, template: true},
+	{name: "export"},
+	{name: "lint", input: inputSelf},
+	{name: "clean", input: inputSelf},
+	{name: "vis"},
+	{name: "count"},
+	{name: "diff", input: inputSelf},
+	{name: "stats"},
+	{name: "style", input: inputSelf},
+	{name: "translit"},
+	{name: "transform", input: inputSelf},
+	{name: "zh"},
+	{name: "idna"},
+	{name: "precis", input: inputLines}, // Lines, as strings can contain spaces.
+	{name: "ident", input: inputLines},
+	{name: "help", input: inputSelf},
+	{name: "version", shortcuts: []string{"v"}, input: inputSelf},
+}
+
+// findCommand finds a command by name or shortcut.
+func findCommand(name string) (command, bool) {
+	for _, c := range commands {
+		if c.name == name {
+			return c, true
+		}
+		for _, s := range c.shortcuts {
+			if s == name {
+				return c, true
+			}
+		}
+	}
+	return command{}, false
+}
+
 var usageShort = zli.Usage(zli.UsageHeaders|zli.UsageProgram|zli.UsageTrim, `
 Usage: %(prog) [command] [flags]
 
@@ -29,7 +90,8 @@ uni queries the unicode database. https://github.com/arp242/uni
 
 Flags:
     -f, -format    Output format.
-    -a, -as        How to print the results: list (default), json, or table.
+    -a, -as        How to print the results: list (default), json, ndjson,
+                   table, chart, card, markdown, html, csv, or tsv.
     -c, -compact   More compact output.
     -r, -raw       Don't use graphical variants or add combining characters.
     -p, -pager     Output to $PAGER.
@@ -41,6 +103,20 @@ Commands:
     search         Search description for any of the words.
     print          Print characters by codepoint, category, or block.
     emoji          Search emojis.
+    export         Export codepoints as code.
+    lint           Check files for invisible and confusing characters.
+    clean          Remove or replace invisible and confusing characters.
+    vis            Show text with invisible characters made visible.
+    count          Count the length in bytes, codepoints, graphemes, etc.
+    diff           Show the codepoints that differ between two strings.
+    stats          Count codepoints per script, block, category, and property.
+    style          Style text as bold, italic, fraktur, etc. or back to plain.
+    translit       Transliterate text to ASCII or Latin.
+    transform      Convert between fullwidth and halfwidth, or kana.
+    zh             Convert between simplified and traditional Chinese.
+    idna           Convert domain names to and from punycode (IDNA).
+    precis         Check usernames, passwords, and nicknames with PRECIS.
+    ident          Check if text is a valid identifier (UAX #31, UTS #39).
 
 Use "%(prog) help" or "%(prog) -h" for a more detailed help.
 `)
@@ -57,18 +133,43 @@ Flags:
     -f, -format    Columns to print and their formatting; see Format section
                    below for details.
 
-    -a, -as        How to print the results: list (default), json, or table.
+    -template      Go text/template to run for every result, instead of
+                   -format; see Template section below. Use @file to read
+                   it from a file.
+
+    -a, -as        How to print the results: list (default), json, ndjson,
+                   table, chart, card, markdown, html, csv, or tsv.
 
                      json    The columns listed in -format are included,
                              ignoring formatting flags. Use "-format all" to
                              include all columns.
+                     ndjson  Newline-delimited JSON: one object per line,
+                             in the same order as the other formats.
                      table   Output as table; instead of listing the codepoints
                              on every line use a table. This ignores the
-                             -format flag.
+                             -format flag. Emojis are grouped by subgroup, with
+                             a column for every -tone and -gender.
+                     chart   Like table, but as a self-contained HTML page
+                             with an SVG chart; hover over a codepoint to see
+                             the name. Unassigned codepoints are greyed out.
+                     card    Print every known attribute, one per line and
+                             grouped in sections. This ignores the -format
+                             flag.
+                     markdown
+                             Markdown table with the columns listed in
+                             -format. The alignment flags are used, but not
+                             widths.
+                     html    HTML <table> with the columns listed in -format.
+                     csv     Comma-separated values; the columns listed in
+                             -format are included, ignoring formatting flags.
+                             The first line is a header with the column names.
+                     tsv     Like csv, but separated by tabs.
 
     -c, -compact   More compact output; don't print header, "no matches", etc.
-                   For json output it uses minified output, and for table it
-                   has less padding.
+                   For json output it uses minified output, for table it has
+                   less padding, for chart the codepoints aren't shown below
+                   the characters, for card there are no blank lines, for markdown the columns aren't padded, and
+                   for html, csv, and tsv there is no header.
 
     -r, -raw       Don't use graphical variants for control characters and
                    don't add ◌ (U+25CC) before combining characters.
@@ -78,9 +179,38 @@ Flags:
     -o, -or        Use "or" when searching: print if at least one parameter
                    matches, instead of only when all parameters match.
 
+    -sort          Sort the results by name, cpoint, block, cat, script, or
+                   age (the Unicode version it was added in). The default is to
+                   sort by codepoint, except for identify (in the order of the
+                   input) and emoji (in the order of the Unicode emoji list).
+                   The emoji command only accepts name and cpoint, and list
+                   only name.
+
+    -reverse       Reverse the sort order.
+
+    -group-by      Group the results by block, script, or cat; for the emoji
+                   command by group or subgroup. This prints a header with the
+                   number of results for every group.
+
+    -limit         Show at most this many results; this applies to every group
+                   with -group-by.
+
+    -offset        Skip this many results before showing anything; this
+                   applies to every group with -group-by.
+
+    -typed         Use numbers and arrays in json and ndjson output, instead of
+                   always using strings: "dec" and "assigned" are numbers,
+                   "cpoint" is a number (or array of numbers for emojis),
+                   byte sequences such as "utf8" are arrays of numbers, and
+                   lists such as "props" and "cldr" are arrays of strings.
+
     -q, -quiet     Backwards-compatible alias for -c/-compact.
     -j, -json      Backwards-compatible alias for -as json
 
+    Some flags such as -to and -profile are used by more than one command; the
+    accepted values and the default are different for every command, and are
+    described with the command below.
+
 Commands:
     list [query]     List an overview of blocks, categories, scripts, or
                      properties. Every name can be abbreviated (i.e. "b" for
@@ -121,8 +251,41 @@ Commands:
 
                        Property    Prefix with "property:", "prop:", or "p:".
 
+                       Reference   Find a codepoint by HTML entity, X11 keysym,
+                                   digraph, or name. For example these are all
+                                   U+20AC (€):
+
+                                     html:euro
+                                     keysym:EuroSign
+                                     digraph:Eu
+                                     name:'EURO SIGN'
+
+                                   The HTML entity, keysym, and digraph are
+                                   case-sensitive; the name is matched loosely
+                                   (case, spaces, "_", and "-" are ignored).
+
                        all         All codepoints we know about.
 
+                       Query       Combine any of the above with "and", "or",
+                                   "not", and parentheses. Ranges must use "..",
+                                   and names with spaces must be quoted. For
+                                   example:
+
+                                     'script:greek and cat:Lu and not prop:deprecated'
+                                     'U+0000..U+FFFF and cat:Sm'
+                                     '(b:arrows or b:box) and not cat:So'
+
+                                   Regular expression \p{..} and \P{..}
+                                   syntax and UnicodeSet syntax with &
+                                   (intersection) and - (difference) can also
+                                   be used:
+
+                                     '\p{Greek} and \p{Lu}'
+                                     '[[:Greek:]&[:Lu:]]'
+                                     '[\p{L}-[a-z]]'
+
+                                   Only assigned codepoints are printed.
+
                     The category, block, and property can be abbreviated, and
                     non-letter characters can be omitted. These are identical:
 
@@ -182,6 +345,295 @@ Commands:
                      in terminals. It's recommended to copy to the clipboard
                      directly with e.g. xclip.
 
+    export [query]   Export codepoints as code; the query is the same as for
+                     print, and all arguments are combined. Consecutive
+                     codepoints are merged in to ranges.
+
+                     Use -to to set the output format:
+
+                         go       Go unicode.RangeTable (default)
+                         re2      Character class for Go regexp and RE2
+                         pcre     Character class for PCRE
+                         js       Character class for JavaScript (needs the
+                                  "u" flag)
+                         python   Character class for Python
+                         c        C array of ranges
+                         json     JSON list of [start, end] pairs
+
+    lint [path..]    Check files for problematic characters; directories are
+                     checked recursively, skipping hidden directories and
+                     binary files. Reads from stdin if no path is given.
+
+                     Every problem is reported as path:line:col, where col is
+                     in codepoints. The checks are:
+
+                         bidi          Bidirectional control characters,
+                                       which can be used to make code look
+                                       different from what it is ("Trojan
+                                       Source"); bidi marks are a warning
+                         invisible     Zero-width and other default-ignorable
+                                       characters; ZWJ and variation
+                                       selectors in emojis are allowed
+                         mixed-script  Identifiers that mix scripts, such as
+                                       Latin and Cyrillic
+                         nfc           Text that isn't in NFC
+                         control       C0 and C1 control characters, except
+                                       tab, form feed, and CR at the line end
+                         bom           Byte order mark at the start of a file
+                         utf8          Invalid UTF-8
+
+                     Use -as json or -as sarif for JSON or SARIF output. The
+                     exit code is 1 if any problems were found.
+
+                     With -diff the input is a unified diff (e.g. from git
+                     diff) and only the added lines are checked; the path and
+                     line numbers are those of the new file:
+
+                         git diff main | uni lint -diff
+
+                     Use -allow to read an allow-list from a file; every line
+                     is a check name or anything print accepts, optionally
+                     prefixed with a glob to match the path:
+
+                         # Allow ZWJ everywhere and a BOM in text files.
+                         U+200D
+                         *.txt bom
+
+    clean [text]     Remove or replace invisible and confusing characters in
+                     the text, according to the policies in -policy. A summary
+                     of what was changed is written to stderr, unless -c is
+                     given.
+
+                     -policy is a comma-separated list of:
+
+                         bidi        Remove bidi control characters
+                         invisible   Remove zero-width and other
+                                     default-ignorable characters; ZWJ and
+                                     variation selectors in emojis are kept
+                         spaces      Replace all spaces (category Zs) with
+                                     U+0020
+                         newlines    Replace CRLF, CR, U+0085, U+2028, and
+                                     U+2029 with a newline
+                         control     Remove control characters, except tab
+                                     and newline
+                         nfc         Normalize to NFC
+                         nfkc        Normalize to NFKC; this also replaces
+                                     compatibility characters such as ﬁ or ①
+                         unassigned  Replace unassigned and private use
+                                     characters with U+FFFD
+
+                     The default is all of them except nfkc; "all" is
+                     accepted as an alias for that.
+
+    vis [text]       Print the text as-is, but with invisible and confusing
+                     characters replaced with a marker, like "cat -A" for
+                     Unicode. The marker is the abbreviation if there is one,
+                     or the codepoint:
+
+                         % uni vis $'a\u00a0b\u200bc\u202e'
+                         a⟨NBSP⟩b⟨ZWSP⟩c⟨RLO⟩
+
+                     This marks control characters (except tab and newline),
+                     bidi controls, default-ignorable characters such as
+                     zero-width spaces, spaces other than U+0020, and
+                     unassigned and private use characters.
+
+                     With -lookalikes non-ASCII characters that look like an
+                     ASCII character are marked as well, for example
+                     ⟨U+0430≈a⟩ for a Cyrillic а.
+
+    count [text]     Count the length of the text in UTF-8 bytes, UTF-16 code
+                     units (the length in JavaScript or Java), codepoints,
+                     extended grapheme clusters, and display cells in a
+                     terminal. Use -lines to count every line separately
+                     instead of the entire text, and -as json for JSON
+                     output.
+
+                     With -truncate the text is truncated instead of
+                     counted, without splitting a grapheme cluster. The
+                     value is in the form of "n" or "n:unit", where unit is
+                     one of utf8 (or bytes), utf16, codepoints (or runes),
+                     graphemes, or cells. The default is graphemes.
+
+                         % uni count -truncate 10:utf16 'Hello 👨‍👩‍👧'
+                         Hello
+
+    diff a b         Show the codepoints that differ between two strings, or
+                     two files with -files. The grapheme clusters in both are
+                     aligned, and every difference is shown as the position in
+                     a and b, what kind of difference it is, and the
+                     codepoints:
+
+                         % uni diff 'café' $'cafe\u0301'
+                         1:4: normalization
+                           - U+00E9   é   Ll  LATIN SMALL LETTER E WITH ACUTE
+                           + U+0065   e   Ll  LATIN SMALL LETTER E
+                           + U+0301   ◌́   Mn  COMBINING ACUTE ACCENT
+
+                     The kind is one of:
+
+                         invisible      Only differs in invisible characters
+                         normalization  Same after NFC normalization
+                         whitespace     Different whitespace characters
+                         compatibility  Same after NFKC normalization, such as
+                                        ﬁ and fi
+                         confusable     Characters that look alike, such as
+                                        Cyrillic а and Latin a
+                         inserted       Only in b
+                         deleted        Only in a
+                         changed        Anything else
+
+                     Use -as json for JSON output. The exit code is 1 if the
+                     strings are different.
+
+    stats [text]     Count the codepoints in the text per script, block,
+                     category, and property, and list unusual codepoints
+                     (invisible characters, unusual spaces, control
+                     characters, and unassigned or private use codepoints)
+                     and rare codepoints (non-ASCII codepoints that occur
+                     once, in a block that makes up 1% of the text or less).
+                     Use -files to read the text from files.
+
+                     All -as values work; with -as table, chart, or card the
+                     distinct codepoints in the text are shown. -as json
+                     writes one object with a key per section, and -as csv
+                     and tsv write one table with a "section" column.
+
+    style st [text]  Style the text with the codepoints for bold, italic, etc.
+                     from the Mathematical Alphanumeric Symbols block and
+                     others:
+
+                         % uni style bold 'Hello'
+                         𝐇𝐞𝐥𝐥𝐨
+
+                     The styles are bold, italic, bold-italic, script,
+                     bold-script, fraktur, bold-fraktur, double-struck, sans,
+                     sans-bold, sans-italic, sans-bold-italic, monospace,
+                     circled, squared, fullwidth, small-caps, superscript,
+                     and subscript. Not every style has every letter (there is
+                     no subscript b, for example); these are left as-is.
+
+                     Use "plain" to convert styled text back to plain text, and
+                     "all" to show the text in all styles.
+
+    translit [text]  Transliterate the text; the -scheme flag controls how:
+
+                         ascii    Fold to ASCII: remove diacritics, and
+                                  replace ligatures and symbols such as Æ
+                                  and € with AE and EUR, after applying all
+                                  the schemes below. Anything that can't be
+                                  represented is replaced with "?". This is
+                                  the default.
+                         iso9     ISO 9 for Cyrillic; this is reversible
+                                  and uses diacritics: Щука → Ŝuka
+                         elot     ELOT 743 for Greek: Αθήνα → Athína
+                         hepburn  Modified Hepburn for hiragana and
+                                  katakana: とうきょう → toukyou
+                         pinyin   Pinyin for Chinese characters, using the
+                                  most common reading: 北京 → běi jīng
+
+                     Text in other scripts is left as-is. The %(ascii)
+                     column shows the ASCII for every codepoint.
+
+    transform tr [text]
+                     Convert the text between fullwidth and halfwidth forms,
+                     or between hiragana and katakana. A summary of what was
+                     changed is written to stderr, unless -c is given.
+
+                     tr is a comma-separated list of:
+
+                         narrow     Fullwidth ASCII and U+3000 to ASCII:
+                                    Ａｂｃ１ → Abc1
+                         wide       ASCII to fullwidth ASCII
+                         fullwidth  Halfwidth katakana, Hangul, and symbols
+                                    to fullwidth, composing the voiced sound
+                                    marks: ｶﾞｷﾞ → ガギ
+                         halfwidth  Katakana, Hangul, and Japanese
+                                    punctuation to halfwidth
+                         hiragana   Katakana to hiragana: カタカナ → かたかな
+                         katakana   Hiragana to katakana
+
+                     For example to normalize Japanese text for searching:
+
+                         % uni transform narrow,fullwidth,katakana 'ＡＢＣ ｶﾞｲﾄﾞ がいど'
+                         ABC ガイド ガイド
+
+    zh [text]        Convert the text between simplified and traditional
+                     Chinese; -to simplified or -to traditional is required. A
+                     summary of what was changed is written to stderr, unless
+                     -c is given.
+
+                     This converts every character on its own, from the
+                     variants in Unihan; characters with more than one
+                     option are reported as "ambiguous" and need to be
+                     checked, for example 发 is 發 in 發現 but 髮 in 頭髮.
+                     These are left as-is if the character is one of the
+                     options, or converted to the first option if it's not.
+
+    idna [domain..]  Convert domain names to their Unicode and ASCII
+                     (punycode) forms, and validate them. Both Unicode and
+                     "xn--" domains are accepted.
+
+                     This uses UTS #46 nontransitional processing, with the
+                     STD3 ASCII rules, and the hyphen, bidi, joiner (CONTEXTJ),
+                     and DNS length checks. Every mapped or ignored codepoint
+                     is listed, and the errors are listed per label.
+
+                     Warnings are added for labels that mix scripts, and for
+                     labels that are confusable with an ASCII label (e.g.
+                     "раураl" with Cyrillic letters).
+
+                     This exits with 1 if any domain is invalid. Use -c to
+                     print only the Unicode and ASCII forms, or -as json for
+                     all details.
+
+                     Use "uni idna -- -ab.com" for domains that start with a
+                     "-", as they're parsed as a flag otherwise.
+
+    precis [text..]  Enforce a PRECIS profile on the text and print the
+                     result, or every disallowed codepoint and its derived
+                     property (PVALID, ID_DIS or FREE_PVAL, CONTEXTJ,
+                     CONTEXTO, DISALLOWED, or UNASSIGNED). Every line is a
+                     string if reading from stdin.
+
+                     The -profile flag sets the profile:
+
+                       usernamecasemapped     Usernames, mapped to lower
+                                              case; default (RFC 8265).
+                       usernamecasepreserved  Usernames, case is kept (RFC
+                                              8265).
+                       opaquestring           Passwords (RFC 8265).
+                       nickname               Nicknames (RFC 8266).
+
+                     This exits with 1 if any string is invalid. Use -c to
+                     print only the result for valid strings, or -as json for
+                     all details.
+
+    ident [text..]   Check if the text is a valid identifier, and list every
+                     codepoint that isn't allowed and why. Every line is an
+                     identifier if reading from stdin.
+
+                     The -profile flag sets the profile:
+
+                       uax31       XID_Start followed by XID_Continue; the
+                                   default identifiers from UAX #31 (default).
+                       go          Letters, decimal digits, and _.
+                       rust        UAX #31 with _; warns if not in NFC.
+                       python      UAX #31 with _; warns if not in NFKC.
+                       javascript  ID_Start, ID_Continue, $, _, ZWNJ, and ZWJ.
+                       restricted  UAX #31, with only the "Allowed"
+                                   Identifier_Status from UTS #39 and no
+                                   mixed scripts (Highly Restrictive level);
+                                   the Identifier_Type is shown for
+                                   codepoints that aren't allowed.
+
+                     This only checks the characters, and not reserved words
+                     such as "func" or "class".
+
+                     This exits with 1 if any identifier is invalid. Use -c to
+                     print only the valid identifiers, or -as json for all
+                     details.
+
 Format:
     You can use the -format or -f flag to control what to print; placeholders
     are in the form of %(name) or %(name flags), where "name" is a column name
@@ -199,6 +651,18 @@ Format:
         %(name t)       Trim this column if it's longer than the screen width
         %(name f:C)     Fill this column with character C; especially useful
                         for numbers: %(bin r:auto f:0)
+        %(name l:auto:5:10)
+                        Pad to the longest value, but at least 5 and at most
+                        10 characters; longer values are cut off. Use 0 for no
+                        minimum: %(name l:auto:0:10)
+        %(name lower)   Convert to lower case; also upper and title
+        %(name d:-)     Use "-" if the value is empty
+        %(utf8 sep:,)   Separate bytes with ","; "sep:" without anything
+                        prints the bytes without a separator
+        %(utf8 pre:0x)  Prefix every byte with "0x"; combine with upper for
+                        C source: %(utf8 sep:, pre:0x upper) → 0xE2,0x82,0xAC
+
+                        sep: and pre: only work for utf8, utf16be, and utf16le.
 
     Placeholders that work for all commands:
         %(tab)           A literal tab when outputting to a terminal, or four
@@ -217,16 +681,23 @@ Format:
         %(utf16le)       As UTF-16 LE (Windows)        13 27
         %(utf16be)       As UTF-16 BE                  27 13
         %(html)          HTML entity                   &check;
+        %(html_all)      All HTML entities             &check; &checkmark;
         %(xml)           XML entity                    &#x2713;
         %(json)          JSON escape                   \u2713
         %(keysym)        X11 keysym; can be blank      checkmark
         %(digraph)       Vim Digraph; can be blank     OK
+        %(ascii)         ASCII; can be blank
+        %(simplified)    Simplified Chinese variants   万 (for 萬)
+        %(traditional)   Traditional Chinese variants  萬 (for 万)
+        %(variants)      All CJK variants              万 (for 萬)
+        %(precis)        PRECIS derived property       ID_DIS or FREE_PVAL
         %(name)          Code point name               CHECK MARK
         %(cat)           Category name                 Other_Symbol
         %(block)         Block name                    Dingbats
         %(props)         Properties, separated by ,    Pattern Syntax
         %(plane)         Plane name                    Basic Multilingual Plane
         %(width)         Character width               Narrow
+        %(age)           Unicode version it was added  1.1
         %(wide_padding)  Blank for wide characters,
                          space otherwise; for alignment
 
@@ -245,15 +716,49 @@ Format:
 
         The default is:
         `+defaultEmojiFormat+`
+
+Template:
+    The -template flag is run as a Go text/template for every result from
+    identify, search, print, and emoji; see
+    https://pkg.go.dev/text/template for the syntax. A newline is added after
+    every result, and it can't be used with -as.
+
+    For codepoints all methods of unidata.Codepoint can be used; for example:
+        {{.Char}}  {{.Rune}}  {{.Name}}  {{.Aliases}}  {{.Category}}
+        {{.Block}}  {{.Script}}  {{.Width}}  {{.Properties}}  {{.Age}}
+        {{.KeySym}}  {{.Digraph}}  {{.HTMLAll}}
+
+    And for emojis all methods of unidata.Emoji:
+        {{.Char}}  {{.Name}}  {{.CLDR}}  {{.Group}}  {{.Subgroup}}
+        {{.Skintones}}  {{.Genders}}  {{.Codepoints}}
+
+    Functions:
+        cpoint   As codepoint(s): {{cpoint .}} → U+20AC
+        utf8     UTF-8 bytes; format with bytes
+        utf16le  UTF-16 LE bytes; format with bytes
+        utf16be  UTF-16 BE bytes; format with bytes
+        bytes    Format bytes with a separator and prefix:
+                 {{utf8 . | bytes ", " "0x"}} → 0xe2, 0x82, 0xac
+        json     JSON escape
+        xml      XML entity
+        html     HTML entity
+        join     Join a list: {{join ", " .CLDR}}
+        lower    Lower case
+        upper    Upper case
+
+    The encoding functions accept a codepoint, emoji, string, or number. For
+    example to generate a C array:
+
+        uni e g:flags -template '{ "{{.Name}}", "{{utf8 . | bytes "" "\\x"}}" },'
 `)
 
 const (
 	defaultFormat = "%(char q l:3)%(wide_padding) %(cpoint l:7) %(dec l:6) %(utf8 l:11) %(html l:10) %(name t) (%(cat t))"
 	allFormat     = "%(char q l:3)%(wide_padding) %(cpoint l:auto) %(width l:auto) %(dec l:auto) %(hex l:auto)" +
 		" %(oct l:auto) %(bin l:auto)" +
-		" %(utf8 l:auto) %(utf16le l:auto) %(utf16be l:auto) %(html l:auto) %(xml l:auto) %(json l:auto)" +
-		" %(keysym l:auto) %(digraph l:auto) %(name l:auto) %(plane l:auto) %(cat l:auto) %(block l:auto)" +
-		" %(script l:auto) %(props l:auto)"
+		" %(utf8 l:auto) %(utf16le l:auto) %(utf16be l:auto) %(html l:auto) %(html_all l:auto) %(xml l:auto) %(json l:auto)" +
+		" %(keysym l:auto) %(digraph l:auto) %(ascii l:auto) %(simplified l:auto) %(traditional l:auto) %(variants l:auto) %(precis l:auto) %(name l:auto) %(plane l:auto) %(cat l:auto) %(block l:auto)" +
+		" %(script l:auto) %(age l:auto) %(props l:auto)"
 
 	defaultEmojiFormat = "%(emoji)%(tab)%(name l:auto)  (%(cldr t))"
 	allEmojiFormat     = "%(emoji)%(tab)%(name l:auto) %(group l:auto) %(subgroup l:auto) %(cpoint l:auto) %(cldr l:auto) %(cldr_full)"
@@ -262,23 +767,40 @@ const (
 func main() {
 	flag := zli.NewFlags(os.Args)
 	var (
-		compact  = flag.Bool(false, "c", "compact", "q", "quiet")
-		help     = flag.Bool(false, "h", "help")
-		versionF = flag.Bool(false, "v", "version")
-		rawF     = flag.Bool(false, "r", "raw")
-		pager    = flag.Bool(false, "p", "pager")
-		or       = flag.Bool(false, "o", "or")
-		formatF  = flag.String(defaultFormat, "format", "f")
-		tone     = flag.String("", "t", "tone", "tones")
-		gender   = flag.String("person", "g", "gender", "genders")
-		asF      = flag.String("list", "a", "as")
-		jsonF    = flag.Bool(false, "json", "j")
+		compact    = flag.Bool(false, "c", "compact", "q", "quiet")
+		help       = flag.Bool(false, "h", "help")
+		versionF   = flag.Bool(false, "v", "version")
+		rawF       = flag.Bool(false, "r", "raw")
+		pager      = flag.Bool(false, "p", "pager")
+		or         = flag.Bool(false, "o", "or")
+		formatF    = flag.String(defaultFormat, "format", "f")
+		tmplF      = flag.String("", "template")
+		tone       = flag.String("", "t", "tone", "tones")
+		gender     = flag.String("person", "g", "gender", "genders")
+		asF        = flag.String("list", "a", "as")
+		to         = flag.String("", "to")
+		jsonF      = flag.Bool(false, "json", "j")
+		typed      = flag.Bool(false, "typed")
+		sortF      = flag.String("", "sort")
+		reverse    = flag.Bool(false, "reverse")
+		groupBy    = flag.String("", "group-by")
+		limit      = flag.Int(0, "limit")
+		offset     = flag.Int(0, "offset")
+		allow      = flag.String("", "allow")
+		diffF      = flag.Bool(false, "diff")
+		policy     = flag.String(defaultCleanPolicy, "policy")
+		lookalikes = flag.Bool(false, "lookalikes")
+		lines      = flag.Bool(false, "lines")
+		truncateF  = flag.String("", "truncate")
+		files      = flag.Bool(false, "files")
+		scheme     = flag.String("ascii", "scheme")
+		profile    = flag.String("", "profile")
 	)
 	err := flag.Parse()
 	zli.F(err)
 
 	if versionF.Set() {
-		fmt.Println(version)
+		fmt.Fprintln(zli.Stdout, version)
 		return
 	}
 
@@ -291,7 +813,17 @@ func main() {
 		return
 	}
 
-	cmd, err := flag.ShiftCommand("list", "identify", "print", "search", "emoji", "help", "version")
+	names := make([]string, 0, len(commands))
+	for _, c := range commands {
+		names = append(names, c.name)
+	}
+	cmd, err := flag.ShiftCommand(names...)
+	var amb zli.ErrCommandAmbiguous
+	if errors.As(err, &amb) {
+		if c, ok := findCommand(amb.Cmd); ok {
+			cmd, err = c.name, nil
+		}
+	}
 	switch cmd {
 	case "":
 		if errors.As(err, &zli.ErrCommandNoneGiven{}) {
@@ -305,20 +837,50 @@ func main() {
 		fmt.Fprint(zli.Stdout, usage)
 		return
 	case "version":
-		fmt.Println(version)
+		fmt.Fprintln(zli.Stdout, version)
 		return
 	}
 
 	var (
-		as    = parseAsFlags(compact, asF, jsonF)
+		c, _  = findCommand(cmd)
+		as    printAs
 		quiet = compact.Set()
 		raw   = rawF.Set()
 		args  = flag.Args
 	)
-	if cmd != "list" {
+	if cmd != "lint" { // lint has its own -as values.
+		as = parseAsFlags(compact, asF, jsonF)
+	}
+	typedJSON = typed.Bool()
+	resultOrder = ordering{reverse: reverse.Bool(), limit: limit.Int(), offset: offset.Int()}
+	if sortF.Set() {
+		resultOrder.sort, err = match(sortF.String(), sortKeys...)
+		if err != nil {
+			zli.Fatalf("unknown value for -sort: %q", sortF.String())
+		}
+	}
+	if groupBy.Set() {
+		resultOrder.groupBy, err = match(groupBy.String(), groupKeys...)
+		if err != nil {
+			zli.Fatalf("unknown value for -group-by: %q", groupBy.String())
+		}
+	}
+	if tmplF.Set() {
+		if formatF.Set() {
+			zli.Fatalf("can't use -format and -template together")
+		}
+		if !c.template {
+			zli.Fatalf("-template doesn't work with the %s command", cmd)
+		}
+	}
+	templateText = tmplF.String()
+	switch c.input {
+	case inputWords:
 		args, err = zli.InputOrArgs(args, "", quiet)
-		zli.F(err)
+	case inputLines:
+		args, err = zli.InputOrArgs(args, "\n", quiet)
 	}
+	zli.F(err)
 
 	format := formatF.String()
 	if !formatF.Set() && cmd == "emoji" {
@@ -344,9 +906,37 @@ func main() {
 	case "emoji":
 		err = emoji(args, format, raw, as, or.Bool(),
 			parseToneFlag(tone.String()), parseGenderFlag(gender.String()))
+	case "export":
+		err = export(args, to.String(), quiet)
+	case "lint":
+		err = lint(args, asF.String(), quiet, diffF.Bool(), allow.String())
+	case "clean":
+		err = clean(args, policy.String(), quiet)
+	case "vis":
+		err = vis(args, lookalikes.Bool())
+	case "count":
+		err = countCmd(args, as, lines.Bool(), truncateF.String())
+	case "diff":
+		err = diffCmd(args, as, files.Bool())
+	case "stats":
+		err = stats(args, format, raw, as, files.Bool())
+	case "style":
+		err = style(args, quiet)
+	case "translit":
+		err = translit(args, scheme.String())
+	case "transform":
+		err = transform(args, quiet)
+	case "zh":
+		err = zh(args, to.String(), quiet)
+	case "idna":
+		err = idnaCmd(args, as)
+	case "precis":
+		err = precisCmd(args, profile.String(), as)
+	case "ident":
+		err = identCmd(args, profile.String(), as)
 	}
 	if err != nil {
-		if !(err == errNoMatches && quiet) {
+		if !(errors.Is(err, errNoMatches) && quiet) && !errors.Is(err, errSilent) {
 			zli.Fatalf(err)
 		}
 		zli.Exit(1)
@@ -378,8 +968,22 @@ func parseAsFlags(compact fb, asF fs, jsonF fb) printAs {
 		as = printAsList
 	case "j", "json":
 		as = printAsJSON
+	case "ndjson":
+		as = printAsNDJSON
 	case "t", "tbl", "table":
 		as = printAsTable
+	case "md", "markdown":
+		as = printAsMarkdown
+	case "html":
+		as = printAsHTML
+	case "chart":
+		as = printAsChart
+	case "card":
+		as = printAsCard
+	case "csv":
+		as = printAsCSV
+	case "tsv":
+		as = printAsTSV
 	}
 
 	if compact.Set() {
@@ -477,13 +1081,18 @@ func match(input string, cmds ...string) (string, error) {
 
 // TODO: this is essentially the same code repeated 3 times; this sucks.
 //
-// TODO: add -order flag; grouping by start codepoint isn't neccisarily all that
-// useful. Allow by name, too, and assigned, and maybe also grouping logically
+// TODO: allow sorting by assigned too, and maybe also grouping logically
 // (alphabets, symbols, CJK, control, etc.)
 func list(ls []string, as printAs) error {
 	if as == printAsTable || as == printAsTableCompact {
 		zli.Fatalf("can't use -as table with the list command")
 	}
+	if as == printAsChart || as == printAsChartCompact {
+		zli.Fatalf("can't use -as chart with the list command")
+	}
+	if as == printAsCard || as == printAsCardCompact {
+		zli.Fatalf("can't use -as card with the list command")
+	}
 
 	if len(ls) == 0 || zstring.Contains(ls, "all") {
 		ls = []string{"blocks", "categories", "scripts", "properties"}
@@ -525,7 +1134,7 @@ func list(ls []string, as printAs) error {
 				as, "from", "to", "assigned", "name")
 			zli.F(err)
 
-			fmtCp := map[bool]string{true: "%X", false: "% 7X"}[f.json()]
+			fmtCp := map[bool]string{true: "%X", false: "% 7X"}[f.json() || f.csv()]
 			for _, b := range order {
 				f.Line(map[string]string{
 					"from":     fmt.Sprintf(fmtCp, b.Range[0]),
@@ -534,6 +1143,7 @@ func list(ls []string, as printAs) error {
 					"name":     b.Name,
 				})
 			}
+			zli.F(f.orderLines())
 			f.Print(zli.Stdout)
 
 		case "scripts":
@@ -567,6 +1177,7 @@ func list(ls []string, as printAs) error {
 					"assigned": strconv.Itoa(assign[s.Const]),
 				})
 			}
+			zli.F(f.orderLines())
 			f.Print(zli.Stdout)
 
 		case "categories":
@@ -619,6 +1230,7 @@ func list(ls []string, as printAs) error {
 					"composed-of": comp,
 				})
 			}
+			zli.F(f.orderLines())
 			f.Print(zli.Stdout)
 
 		case "properties":
@@ -652,6 +1264,7 @@ func list(ls []string, as printAs) error {
 					"name":     b.Name,
 				})
 			}
+			zli.F(f.orderLines())
 			f.Print(zli.Stdout)
 		}
 	}
@@ -675,7 +1288,7 @@ func identify(ins []string, format string, raw bool, as printAs) error {
 			return fmt.Errorf("unknown codepoint: U+%.4X", c) // Should never happen.
 		}
 
-		f.Line(f.toLine(info, raw))
+		f.Codepoint(info, raw)
 	}
 	f.Print(zli.Stdout)
 	return nil
@@ -702,6 +1315,7 @@ func search(args []string, format string, raw bool, as printAs, or bool) error {
 	if err != nil {
 		return err
 	}
+	f.DefaultSort("cpoint") // Codepoints is a map, so always sort.
 
 	for _, info := range unidata.Codepoints {
 		m := 0
@@ -709,7 +1323,7 @@ func search(args []string, format string, raw bool, as printAs, or bool) error {
 			if strings.Contains(info.Name(), a) {
 				if or {
 					found = true
-					f.Line(f.toLine(info, raw))
+					f.Codepoint(info, raw)
 					break
 				}
 				m++
@@ -717,14 +1331,13 @@ func search(args []string, format string, raw bool, as printAs, or bool) error {
 		}
 		if !or && m == len(args) {
 			found = true
-			f.Line(f.toLine(info, raw))
+			f.Codepoint(info, raw)
 		}
 	}
 
 	if !found {
 		return errNoMatches
 	}
-	f.SortNum("dec")
 	f.Print(zli.Stdout)
 	return nil
 }
@@ -746,44 +1359,47 @@ func print(args []string, format string, raw bool, as printAs) error {
 	if err != nil {
 		return err
 	}
+	f.DefaultSort("cpoint") // Codepoints is a map, so always sort.
 	for _, a := range args {
-		a = strings.ToLower(a)
-
-		// UTF-8
-		if strings.HasPrefix(a, "utf8:") {
-			a = a[5:]
-
-			seq := utfClean.Replace(a)
-			if len(seq)%2 == 1 {
-				seq = "0" + seq
+		if isQuery(a) {
+			s, err := parseQuery(a)
+			if err != nil {
+				return err
 			}
-
-			byt := make([]byte, 0, len(seq)/2)
-			for i := 0; len(seq) > i; i += 2 {
-				b, err := strconv.ParseUint(seq[i:i+2], 16, 8)
-				if err != nil {
-					return fmt.Errorf("invalid UTF-8 sequence %q: %q is not a hex number",
-						a, seq[i:i+2])
+			s.each(func(cp rune) {
+				if info, ok := unidata.Find(cp); ok {
+					f.Codepoint(info, raw)
 				}
-				byt = append(byt, byte(b))
-			}
+			})
+			continue
+		}
 
-			r, s := utf8.DecodeRune(byt)
-			if r == utf8.RuneError {
-				return fmt.Errorf("invalid UTF-8 sequence: %q", a)
-			}
-			if s != len(byt) {
-				return fmt.Errorf("multiple characters in sequence %q", a)
+		// HTML entity, keysym, digraph, or name. Most of these are
+		// case-sensitive, so do this before lowercasing.
+		if info, ok, err := findRef(a); ok {
+			if err != nil {
+				return err
 			}
+			f.Codepoint(info, raw)
+			continue
+		}
 
-			f.Line(f.toLine(unidata.Codepoints[r], raw))
+		a = strings.ToLower(a)
+
+		// UTF-8
+		if strings.HasPrefix(a, "utf8:") {
+			r, err := parseUTF8(a[5:])
+			if err != nil {
+				return err
+			}
+			f.Codepoint(unidata.Codepoints[r], raw)
 			continue
 		}
 
 		// Print everything.
 		if strings.ToLower(a) == "all" {
 			for _, info := range unidata.Codepoints {
-				f.Line(f.toLine(info, raw))
+				f.Codepoint(info, raw)
 			}
 			continue
 		}
@@ -850,11 +1466,11 @@ func print(args []string, format string, raw bool, as printAs) error {
 
 			for _, info := range unidata.Codepoints {
 				if info.Category() == cat {
-					f.Line(f.toLine(info, raw))
+					f.Codepoint(info, raw)
 				}
 				for _, incl := range cc.Include {
 					if info.Category() == incl {
-						f.Line(f.toLine(info, raw))
+						f.Codepoint(info, raw)
 					}
 				}
 			}
@@ -871,7 +1487,7 @@ func print(args []string, format string, raw bool, as printAs) error {
 				for cp := pp[0]; cp <= pp[1]; cp++ {
 					s, ok := unidata.Codepoints[cp]
 					if ok {
-						f.Line(f.toLine(s, raw))
+						f.Codepoint(s, raw)
 					}
 				}
 			}
@@ -888,7 +1504,7 @@ func print(args []string, format string, raw bool, as printAs) error {
 			for cp := unidata.Blocks[bl].Range[0]; cp <= unidata.Blocks[bl].Range[1]; cp++ {
 				s, ok := unidata.Codepoints[cp]
 				if ok {
-					f.Line(f.toLine(s, raw))
+					f.Codepoint(s, raw)
 				}
 			}
 			continue
@@ -903,56 +1519,122 @@ func print(args []string, format string, raw bool, as printAs) error {
 				for cp := pp[0]; cp <= pp[1]; cp++ {
 					s, ok := unidata.Codepoints[cp]
 					if ok {
-						f.Line(f.toLine(s, raw))
+						f.Codepoint(s, raw)
 					}
 				}
 			}
 			continue
 		}
 
-		// U2042, U+2042, U+2042..U+2050, 2042..2050, 2042-2050, 0x2041, etc.
-		var s []string
-		switch {
-		case strings.Contains(a, ".."):
-			s = strings.SplitN(a, "..", 2)
-		case strings.Contains(a, "-"):
-			s = strings.SplitN(a, "-", 2)
-		default:
-			s = []string{a, a}
-		}
-		s[0], s[1] = strings.TrimSpace(s[0]), strings.TrimSpace(s[1])
-
-		start, err := unidata.FromString(s[0])
+		// Codepoint or range.
+		start, end, err := parseRange(a)
 		if err != nil {
-			return fmt.Errorf("invalid codepoint: %s", errors.Unwrap(err))
+			return err
 		}
-		end, err := unidata.FromString(s[1])
-		if err != nil {
-			return fmt.Errorf("invalid codepoint: %s", errors.Unwrap(err))
-		}
-		if start.Codepoint > end.Codepoint {
-			zli.Fatalf("end of range %q is lower than start %q", s[1], s[0])
-		}
-
-		for i := start.Codepoint; i <= end.Codepoint; i++ {
+		for i := start; i <= end; i++ {
 			info, _ := unidata.Find(i)
-			f.Line(f.toLine(info, raw))
+			f.Codepoint(info, raw)
 		}
 	}
-	f.SortNum("dec")
 	f.Print(zli.Stdout)
 	return nil
 }
 
-func emoji(args []string, format string, raw bool, as printAs, or bool, tones, genders unidata.EmojiModifier) error {
-	if as == printAsTable || as == printAsTableCompact {
-		// TODO: it should
-		// The reason it doesn't work is because printTbl() assumes that every
-		// entry is a codepoint. Should instead duplicate some data in
-		// Format.tblData, instead of using []unidata.Codepoint.
-		return errors.New("-as table doesn't work with the emoji command")
+// parseUTF8 parses a UTF-8 byte sequence as hex, optionally separated by any
+// combination of "0x", "-", "_", or spaces.
+func parseUTF8(a string) (rune, error) {
+	seq := utfClean.Replace(a)
+	if len(seq)%2 == 1 {
+		seq = "0" + seq
+	}
+
+	byt := make([]byte, 0, len(seq)/2)
+	for i := 0; len(seq) > i; i += 2 {
+		b, err := strconv.ParseUint(seq[i:i+2], 16, 8)
+		if err != nil {
+			return 0, fmt.Errorf("invalid UTF-8 sequence %q: %q is not a hex number",
+				a, seq[i:i+2])
+		}
+		byt = append(byt, byte(b))
+	}
+
+	r, s := utf8.DecodeRune(byt)
+	if r == utf8.RuneError {
+		return 0, fmt.Errorf("invalid UTF-8 sequence: %q", a)
+	}
+	if s != len(byt) {
+		return 0, fmt.Errorf("multiple characters in sequence %q", a)
+	}
+	return r, nil
+}
+
+// parseRange parses a codepoint or range of codepoints:
+//
+//	U2042, U+2042, U+2042..U+2050, 2042..2050, 2042-2050, 0x2041, etc.
+func parseRange(a string) (rune, rune, error) {
+	var s []string
+	switch {
+	case strings.Contains(a, ".."):
+		s = strings.SplitN(a, "..", 2)
+	case strings.Contains(a, "-"):
+		s = strings.SplitN(a, "-", 2)
+	default:
+		s = []string{a, a}
+	}
+	s[0], s[1] = strings.TrimSpace(s[0]), strings.TrimSpace(s[1])
+
+	start, err := unidata.FromString(s[0])
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid codepoint: %s", errors.Unwrap(err))
+	}
+	end, err := unidata.FromString(s[1])
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid codepoint: %s", errors.Unwrap(err))
+	}
+	if start.Codepoint > end.Codepoint {
+		return 0, 0, fmt.Errorf("end of range %q is lower than start %q", s[1], s[0])
 	}
+	return start.Codepoint, end.Codepoint, nil
+}
 
+// findRef finds a codepoint by a reference such as "html:euro",
+// "keysym:EuroSign", "digraph:Eu", or "name:euro sign".
+//
+// The second return value is false if this isn't a reference.
+func findRef(a string) (unidata.Codepoint, bool, error) {
+	i := strings.IndexByte(a, ':')
+	if i == -1 {
+		return unidata.Codepoint{}, false, nil
+	}
+
+	var (
+		info unidata.Codepoint
+		ok   bool
+		kind = strings.ToLower(a[:i])
+		ref  = strings.TrimSpace(a[i+1:])
+	)
+	switch kind {
+	default:
+		return unidata.Codepoint{}, false, nil
+	case "html":
+		info, ok = unidata.FromHTML(ref)
+	case "keysym":
+		info, ok = unidata.FromKeySym(ref)
+	case "digraph":
+		info, ok = unidata.FromDigraph(ref)
+	case "name":
+		info, ok = unidata.FromName(ref)
+	}
+	if !ok {
+		return info, true, fmt.Errorf("unknown %s: %q", kind, ref)
+	}
+	return info, true, nil
+}
+
+func emoji(args []string, format string, raw bool, as printAs, or bool, tones, genders unidata.EmojiModifier) error {
+	if as == printAsChart || as == printAsChartCompact {
+		return errors.New("-as chart doesn't work with the emoji command")
+	}
 	type matchArg struct {
 		group bool
 		name  bool
@@ -977,7 +1659,10 @@ func emoji(args []string, format string, raw bool, as printAs, or bool, tones, g
 		}
 	}
 
-	out := make([]unidata.Emoji, 0, 16)
+	var (
+		out   = make([]unidata.Emoji, 0, 16)
+		found = make([]unidata.Emoji, 0, 16) // Without tones and genders applied.
+	)
 	for _, e := range unidata.Emojis {
 		m := 0
 		for _, a := range matchArgs {
@@ -995,13 +1680,14 @@ func emoji(args []string, format string, raw bool, as printAs, or bool, tones, g
 			if match {
 				m++
 				if or {
-					out = append(out, e)
+					out, found = append(out, e), append(found, e)
 					break
 				}
 			}
 		}
 		if all || (!or && m == len(matchArgs)) {
 			out = append(out, applyGenders(applyTones(e, tones), genders)...)
+			found = append(found, e)
 		}
 	}
 
@@ -1014,38 +1700,131 @@ func emoji(args []string, format string, raw bool, as printAs, or bool, tones, g
 	if err != nil {
 		return err
 	}
-	for _, e := range out {
-		f.Line(map[string]string{
-			"emoji":    e.String(),
-			"name":     e.Name,
-			"group":    e.Group().String(),
-			"subgroup": e.Subgroup().String(),
-			"tab":      tabOrSpace(),
-			"cldr": func() string {
-				// Remove words that duplicate what's already in the name; it's
-				// kind of pointless.
-				cldr := make([]string, 0, len(e.CLDR))
-				for _, c := range e.CLDR {
-					if !strings.Contains(e.Name, c) {
-						cldr = append(cldr, c)
-					}
-				}
-				return strings.Join(cldr, ", ")
-			}(),
-			"cldr_full": strings.Join(e.CLDR, ", "),
-			"cpoint": func() string {
-				cp := make([]string, 0, len(e.Codepoints))
-				for _, c := range e.String() { // String() inserts ZWJ and whatnot
-					cp = append(cp, fmt.Sprintf("U+%04X", c))
-				}
-				return strings.Join(cp, " ")
-			}(),
-		})
+	if f.tbl() {
+		var mods []unidata.EmojiModifier
+		mods, f.tblHead = emojiColumns(tones, genders)
+		for _, e := range found {
+			f.tblData = append(f.tblData, tblEntry{emoji: emojiVariants(e, mods, tones, genders)})
+		}
+		f.Print(zli.Stdout)
+		return nil
+	}
+
+	for _, g := range orderEmojis(out, f.order) {
+		start, end := f.order.page(len(g.emojis))
+		if start == end {
+			continue
+		}
+		if f.order.groupBy != "" {
+			f.Group(f.order.groupHeader(g.name, end-start, len(g.emojis)))
+		}
+		for _, e := range g.emojis[start:end] {
+			if f.card() {
+				f.tblData = append(f.tblData, tblEntry{emoji: []unidata.Emoji{e}})
+				continue
+			}
+			f.Emoji(e)
+		}
 	}
 	f.Print(zli.Stdout)
 	return nil
 }
 
+func emojiLine(e unidata.Emoji) map[string]string {
+	return map[string]string{
+		"emoji":    e.String(),
+		"name":     e.Name,
+		"group":    e.Group().String(),
+		"subgroup": e.Subgroup().String(),
+		"tab":      tabOrSpace(),
+		"cldr": func() string {
+			// Remove words that duplicate what's already in the name; it's
+			// kind of pointless.
+			cldr := make([]string, 0, len(e.CLDR))
+			for _, c := range e.CLDR {
+				if !strings.Contains(e.Name, c) {
+					cldr = append(cldr, c)
+				}
+			}
+			return strings.Join(cldr, ", ")
+		}(),
+		"cldr_full": strings.Join(e.CLDR, ", "),
+		"cpoint": func() string {
+			cp := make([]string, 0, len(e.Codepoints))
+			for _, c := range e.String() { // String() inserts ZWJ and whatnot
+				cp = append(cp, fmt.Sprintf("U+%04X", c))
+			}
+			return strings.Join(cp, " ")
+		}(),
+	}
+}
+
+// emojiColumns gets the modifiers for every column for -as table, and the
+// column headers. There is one column for every combination of skin tone and
+// gender.
+func emojiColumns(tones, genders unidata.EmojiModifier) ([]unidata.EmojiModifier, []string) {
+	var (
+		toneMods   = []unidata.EmojiModifier{0}
+		toneNames  = []string{""}
+		genderMods = []unidata.EmojiModifier{0}
+		genderName = []string{""}
+	)
+	if tones != 0 {
+		toneMods, toneNames = nil, nil
+		for i, n := range []string{"none", "light", "ml", "medium", "md", "dark"} {
+			if m := unidata.ModNone << i; tones&m != 0 {
+				toneMods, toneNames = append(toneMods, m), append(toneNames, n)
+			}
+		}
+	}
+	if genders&^unidata.ModPerson != 0 { // Person is the default.
+		genderMods, genderName = nil, nil
+		for i, n := range []string{"person", "man", "woman"} {
+			if m := unidata.ModPerson << i; genders&m != 0 {
+				genderMods, genderName = append(genderMods, m), append(genderName, n)
+			}
+		}
+	}
+
+	var (
+		mods = make([]unidata.EmojiModifier, 0, len(toneMods)*len(genderMods))
+		head = make([]string, 0, len(toneMods)*len(genderMods))
+	)
+	for i, t := range toneMods {
+		for j, g := range genderMods {
+			mods = append(mods, t|g)
+			head = append(head, strings.Trim(genderName[j]+" "+toneNames[i], " "))
+		}
+	}
+	return mods, head
+}
+
+// emojiVariants gets the variants of an emoji for every column in mods; the
+// variant is empty if the emoji doesn't support the modifier.
+func emojiVariants(e unidata.Emoji, mods []unidata.EmojiModifier, tones, genders unidata.EmojiModifier) []unidata.Emoji {
+	var (
+		firstTone   = mods[0] &^ (unidata.ModPerson | unidata.ModMale | unidata.ModFemale)
+		firstGender = mods[0] & (unidata.ModPerson | unidata.ModMale | unidata.ModFemale)
+		variants    = make([]unidata.Emoji, len(mods))
+	)
+	for i, m := range mods {
+		var (
+			tone   = m &^ (unidata.ModPerson | unidata.ModMale | unidata.ModFemale)
+			gender = m & (unidata.ModPerson | unidata.ModMale | unidata.ModFemale)
+		)
+		switch {
+		case tone != firstTone && !e.Skintones(), gender != firstGender && !e.Genders():
+			continue
+		case !e.Skintones():
+			m = gender
+		case !e.Genders():
+			m = tone
+		}
+		variants[i] = e.With(m)
+	}
+	return variants
+}
+
 func applyAll(e unidata.Emoji, mod unidata.EmojiModifier) []unidata.Emoji {
 	emojis := make([]unidata.Emoji, 0, 1)
 	i := unidata.EmojiModifier(1)
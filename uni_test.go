@@ -2,9 +2,12 @@ package main
 
 import (
 	"bytes"
+	"encoding/csv"
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"os"
+	"path/filepath"
 	"reflect"
 	"sort"
 	"strings"
@@ -28,6 +31,7 @@ func TestCLI(t *testing.T) {
 		{[]string{"e", "-tone", "xx"}, "invalid skin"},
 		{[]string{"e", "-gender", "xx"}, "invalid gender"},
 		{[]string{"e", "-g", "xxsxxxx"}, `invalid gender: "xxsxxxx"`},
+		{[]string{"zh", "漢"}, "zh: need -to simplified or -to traditional"},
 	}
 
 	for _, tt := range tests {
@@ -56,6 +60,65 @@ func TestCLI(t *testing.T) {
 	})
 }
 
+// Prefixes that worked before new commands were added should still run the
+// same command.
+func TestShortcuts(t *testing.T) {
+	tests := []struct {
+		in   []string
+		want string
+	}{
+		{[]string{"li", "blocks"}, "Blocks:"},
+		{[]string{"v"}, "git"},
+		{[]string{"id", "a"}, "LATIN SMALL LETTER A"},
+		{[]string{"pr", "U+41"}, "LATIN CAPITAL LETTER A"},
+		{[]string{"ide", "a"}, "LATIN SMALL LETTER A"},
+		{[]string{"iden", "a"}, "LATIN SMALL LETTER A"},
+		{[]string{"ident", "a"}, "valid:    yes"}, // Exact match.
+		{[]string{"identi", "a"}, "LATIN SMALL LETTER A"},
+		{[]string{"identif", "a"}, "LATIN SMALL LETTER A"},
+	}
+
+	for _, tt := range tests {
+		t.Run(strings.Join(tt.in, "_"), func(t *testing.T) {
+			exit, _, out := zli.Test(t)
+			os.Args = append([]string{"testuni"}, tt.in...)
+
+			func() {
+				defer exit.Recover()
+				main()
+			}()
+
+			if !strings.Contains(out.String(), tt.want) {
+				t.Errorf("wrong output\nout:  %q\nwant: %q", out.String(), tt.want)
+			}
+			if *exit != -1 {
+				t.Errorf("wrong exit: %d", *exit)
+			}
+		})
+	}
+}
+
+func TestCommands(t *testing.T) {
+	seen := make(map[string]bool)
+	for _, c := range commands {
+		if seen[c.name] {
+			t.Errorf("duplicate: %q", c.name)
+		}
+		seen[c.name] = true
+	}
+	for _, c := range commands {
+		for _, s := range c.shortcuts {
+			if !strings.HasPrefix(c.name, s) {
+				t.Errorf("%s: shortcut %q isn't a prefix", c.name, s)
+			}
+			if seen[s] {
+				t.Errorf("%s: shortcut %q is already used", c.name, s)
+			}
+			seen[s] = true
+		}
+	}
+}
+
 func TestIdentify(t *testing.T) {
 	tests := []struct {
 		in   []string
@@ -173,6 +236,39 @@ func TestPrint(t *testing.T) {
 		{[]string{"-q", "p", "utf8:e2 82 ac"}, "'€'", 1, -1},
 		{[]string{"-q", "p", "utf8:0xe20x820xac"}, "'€'", 1, -1},
 		{[]string{"-q", "p", "utf8:0xE2 0x82 0xAC"}, "'€'", 1, -1},
+
+		// Reverse lookups
+		{[]string{"-q", "p", "html:euro"}, "'€'", 1, -1},
+		{[]string{"-q", "p", "html:&euro;"}, "'€'", 1, -1},
+		{[]string{"-q", "p", "html:&#x20ac;"}, "'€'", 1, -1},
+		{[]string{"-q", "p", "html:AMP"}, "AMPERSAND", 1, -1},
+		{[]string{"-q", "p", "html:Euro"}, `unknown html: "Euro"`, 1, 1},
+		{[]string{"-q", "p", "keysym:EuroSign"}, "'€'", 1, -1},
+		{[]string{"-q", "p", "digraph:Eu"}, "'€'", 1, -1},
+		{[]string{"-q", "p", "digraph:=e"}, "'€'", 1, -1},
+		{[]string{"-q", "p", "digraph:=P"}, "RUBLE SIGN", 1, -1},
+		{[]string{"-q", "p", "name:EURO SIGN"}, "'€'", 1, -1},
+		{[]string{"-q", "p", "name:euro_sign"}, "'€'", 1, -1},
+		{[]string{"-q", "p", "name:hangul jungseong oe"}, "U+116C", 1, -1},
+		{[]string{"-q", "p", "name:hangul jungseong o-e"}, "U+1180", 1, -1},
+		{[]string{"-q", "p", "name:not a name"}, `unknown name: "not a name"`, 1, 1},
+		{[]string{"-q", "-f", "%(html_all)", "p", "U+2713"}, "&check; &checkmark;", 1, -1},
+
+		// Queries
+		{[]string{"-q", "p", "script:greek and cat:Lu and not prop:deprecated"}, "GREEK CAPITAL LETTER HETA", 123, -1},
+		{[]string{"-q", "p", "U+0000..U+007F and cat:Sm"}, "PLUS SIGN", 6, -1},
+		{[]string{"-q", "p", "(U+41..U+43 or U+61..U+63) and not cat:Ll"}, "LATIN CAPITAL LETTER C", 3, -1},
+		{[]string{"-q", "p", `\p{Greek} and \p{Lu}`}, "GREEK CAPITAL LETTER HETA", 123, -1},
+		{[]string{"-q", "p", `\P{L} and U+30..U+41`}, "DIGIT ZERO", 17, -1},
+		{[]string{"-q", "p", "[[:Greek:]&[:Lu:]]"}, "GREEK CAPITAL LETTER HETA", 123, -1},
+		{[]string{"-q", "p", `[\p{L}-[a-z]] and U+0..U+7F`}, "LATIN CAPITAL LETTER A", 26, -1},
+		{[]string{"-q", "p", "[a-c\\u00e9]"}, "LATIN SMALL LETTER E WITH ACUTE", 4, -1},
+		{[]string{"-q", "p", "html:euro or digraph:Pd"}, "POUND SIGN", 2, -1},
+		{[]string{"p", "cat:Lu and"}, "unexpected end of query", 1, 1},
+		{[]string{"p", "(cat:Lu"}, "missing ) in query", 1, 1},
+		{[]string{"p", "cat:Lu and script:xxx"}, `unknown or ambiguous script: "xxx"`, 1, 1},
+		{[]string{"-q", "p", "block:Halfwidth and Fullwidth Forms"}, "HALFWIDTH KATAKANA LETTER A", 225, -1},
+		{[]string{"-q", "p", "Miscellaneous Symbols and Arrows"}, "NORTH WEST WHITE ARROW", 253, -1},
 	}
 
 	for _, tt := range tests {
@@ -200,6 +296,74 @@ func TestPrint(t *testing.T) {
 	}
 }
 
+func TestExport(t *testing.T) {
+	tests := []struct {
+		in       []string
+		want     string
+		wantExit int
+	}{
+		{[]string{"export", "U+41..U+43", "U+61", "U+100", "U+102", "U+104", "U+1F600"}, `
+var table = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x0041, 0x0043, 1},
+		{0x0061, 0x0061, 1},
+		{0x0100, 0x0104, 2},
+	},
+	R32: []unicode.Range32{
+		{0x1f600, 0x1f600, 1},
+	},
+	LatinOffset: 2,
+}`, -1},
+		{[]string{"export", "U+FFF0..U+10010"}, `
+var table = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0xfff0, 0xffff, 1},
+	},
+	R32: []unicode.Range32{
+		{0x10000, 0x10010, 1},
+	},
+}`, -1},
+		{[]string{"export", "-to", "re2", "U+41..U+43 or U+2E or U+1F600"}, `[\x{2E}A-C\x{1F600}]`, -1},
+		{[]string{"export", "-to", "pcre", "U+41..U+42"}, `[AB]`, -1},
+		{[]string{"export", "-to", "js", "U+2E or U+1F600..U+1F603"}, `[\u{2E}\u{1F600}-\u{1F603}]`, -1},
+		{[]string{"export", "-to", "py", "U+2E or U+1F600..U+1F603"}, `[\u002E\U0001F600-\U0001F603]`, -1},
+		{[]string{"export", "-to", "c", "U+41..U+43"}, `
+static const struct { uint32_t start, end; } ranges[] = {
+	{0x0041, 0x0043},
+};`, -1},
+		{[]string{"export", "-to", "json", "-c", "U+41..U+43", "U+61"}, `[[65,67],[97,97]]`, -1},
+		{[]string{"export", "-to", "json", "U+41..U+43 and cat:Ll"}, `uni: no matches`, 1},
+
+		{[]string{"export", "-to", "xxx", "U+41"}, `unknown value for -to: "xxx"`, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(strings.Join(tt.in, "_"), func(t *testing.T) {
+			exit, _, outbuf := zli.Test(t)
+			os.Args = append([]string{"testuni"}, tt.in...)
+
+			func() {
+				defer exit.Recover()
+				main()
+			}()
+			if int(*exit) != tt.wantExit {
+				t.Fatalf("wrong exit: %d; out: %s", *exit, outbuf.String())
+			}
+
+			out := strings.TrimSpace(outbuf.String())
+			if tt.wantExit == 1 {
+				if !strings.Contains(out, tt.want) {
+					t.Errorf("wrong output\nout:  %q\nwant: %q", out, tt.want)
+				}
+				return
+			}
+			if d := ztest.Diff(out, strings.TrimSpace(tt.want)); d != "" {
+				t.Error(d)
+			}
+		})
+	}
+}
+
 func TestEmoji(t *testing.T) {
 	tests := []struct {
 		in   []string
@@ -274,6 +438,40 @@ func TestEmoji(t *testing.T) {
 	}
 }
 
+func TestEmojiTable(t *testing.T) {
+	tests := []struct {
+		in   []string
+		want string
+	}{
+		{[]string{"e", "-as", "table", "-c", "g:cat-face", "g:monkey-face", "-or"}, `
+Smileys & Emotion
+   cat-face    │ 😺  😸  😹  😻  😼  😽  🙀  😿  😾
+   monkey-face │ 🙈  🙉  🙊
+`},
+		{[]string{"e", "-as", "table", "-tone", "none,dark", "n:waving hand", "n:beaming face", "-or"}, `
+                       none dark
+Smileys & Emotion
+   face-smiling      │ 😁
+                     │
+People & Body
+   hand-fingers-open │ 👋   👋🏿
+                     │
+`},
+	}
+
+	for _, tt := range tests {
+		t.Run(strings.Join(tt.in, "_"), func(t *testing.T) {
+			_, _, outbuf := zli.Test(t)
+			os.Args = append([]string{"testuni"}, tt.in...)
+			main()
+
+			if d := ztest.Diff(outbuf.String(), tt.want[1:]); d != "" {
+				t.Error(d)
+			}
+		})
+	}
+}
+
 func TestAllEmoji(t *testing.T) {
 	exit, _, outbuf := zli.Test(t)
 	os.Args = append([]string{"testuni"}, []string{"e", "-q", "-gender", "all", "-tone", "all", "all"}...)
@@ -338,6 +536,8 @@ func TestJSON(t *testing.T) {
 	main()
 
 	want := ` [{
+	"age": "2.1",
+	"ascii": "EUR",
 	"bin": "10000010101100",
 	"block": "Currency Symbols",
 	"cat": "Currency_Symbol",
@@ -347,16 +547,21 @@ func TestJSON(t *testing.T) {
 	"digraph": "=e",
 	"hex": "20ac",
 	"html": "&euro;",
+	"html_all": "&euro;",
 	"json": "\\u20ac",
 	"keysym": "EuroSign",
 	"name": "EURO SIGN",
 	"oct": "20254",
 	"plane": "Basic Multilingual Plane",
+	"precis": "ID_DIS or FREE_PVAL",
 	"props": "",
 	"script": "Common",
+	"simplified": "",
+	"traditional": "",
 	"utf16be": "20 ac",
 	"utf16le": "ac 20",
 	"utf8": "e2 82 ac",
+	"variants": "",
 	"width": "ambiguous",
 	"xml": "&#x20ac;"
 }]
@@ -368,6 +573,1099 @@ func TestJSON(t *testing.T) {
 	}
 }
 
+func TestCSV(t *testing.T) {
+	tests := []struct {
+		in   []string
+		want string
+	}{
+		{[]string{"i", "a,\"€", "-as", "csv", "-f", "%(char q l:3)%(wide_padding) %(cpoint) %(name t)"}, `
+char,cpoint,name
+a,U+0061,LATIN SMALL LETTER A
+",",U+002C,COMMA
+"""",U+0022,QUOTATION MARK
+€,U+20AC,EURO SIGN
+`},
+		{[]string{"i", "a€", "-as", "tsv", "-c", "-f", "%(cpoint) %(name) %(utf8)"}, `
+U+0061	LATIN SMALL LETTER A	61
+U+20AC	EURO SIGN	e2 82 ac
+`},
+		{[]string{"e", "-as", "csv", "-f", "%(emoji)%(tab)%(name) %(cldr)", "shrug"}, `
+emoji,name,cldr
+🤷,person shrugging,"doubt, ignorance, indifference"
+`},
+	}
+
+	for _, tt := range tests {
+		t.Run(strings.Join(tt.in, "_"), func(t *testing.T) {
+			_, _, outbuf := zli.Test(t)
+			os.Args = append([]string{"testuni"}, tt.in...)
+			main()
+
+			if d := ztest.Diff(outbuf.String(), tt.want[1:]); d != "" {
+				t.Error(d)
+			}
+		})
+	}
+}
+
+func TestFormatFlags(t *testing.T) {
+	tests := []struct {
+		in   []string
+		want string
+	}{
+		{[]string{"i", "€a", "-c", "-f", "%(utf8 sep:, pre:0x upper) %(name title l:auto:0:8)|%(keysym d:- l:auto:10)|%(digraph d:-)"}, `
+0xE2,0x82,0xAC Euro Si…|EuroSign  |=e
+0x61 Latin S…|a         |-
+`},
+		{[]string{"i", "€", "-c", "-f", "%(utf16be sep: pre:\\x)|%(name lower)"}, `
+\x20\xac|euro sign
+`},
+		{[]string{"i", "€", "-as", "json", "-c", "-f", "%(utf8 sep:, pre:0x) %(digraph upper)"}, `
+[{"digraph":"=E","utf8":"0xe2,0x82,0xac"}]
+`},
+	}
+
+	for _, tt := range tests {
+		t.Run(strings.Join(tt.in, "_"), func(t *testing.T) {
+			_, _, outbuf := zli.Test(t)
+			os.Args = append([]string{"testuni"}, tt.in...)
+			main()
+
+			if d := ztest.Diff(outbuf.String(), tt.want[1:]); d != "" {
+				t.Error(d)
+			}
+		})
+	}
+}
+
+func TestTemplate(t *testing.T) {
+	tests := []struct {
+		in   []string
+		want string
+	}{
+		{[]string{"i", "€\u0085", "-template", `{{.Char}} {{.Rune}} {{.Name}} {{utf8 . | bytes "," "0x"}}{{range .Aliases}} [{{.}}]{{end}}`}, `
+€ 8364 EURO SIGN 0xe2,0x82,0xac
+␣ 133 NEXT LINE (NEL) 0xc2,0x85 [NEXT LINE] [NEL]
+`},
+		{[]string{"e", "shrug", "-tone", "dark", "-template", `{{.Char}} {{.Group}}/{{.Subgroup}} {{.Skintones}} {{cpoint .}} {{utf16be .Char | bytes "" ""}}`}, `
+🤷🏿 People & Body/person-gesture true U+1F937 U+1F3FF d83edd37d83cdfff
+`},
+		{[]string{"p", "U+2190", "U+02C2", "-group-by", "block", "-template", `{{json .}}{{xml .}}{{html .Rune}}`}, `
+Block Spacing Modifier Letters (1)
+\u02c2&#x2c2;&#x2c2;
+
+Block Arrows (1)
+\u2190&#x2190;&larr;
+`},
+		// Categories come from a map, so this needs to be sorted.
+		{[]string{"-q", "p", "cat:Zs", "-template", "{{cpoint .}}"}, `
+U+0020
+U+00A0
+U+1680
+U+2000
+U+2001
+U+2002
+U+2003
+U+2004
+U+2005
+U+2006
+U+2007
+U+2008
+U+2009
+U+200A
+U+202F
+U+205F
+U+3000
+`},
+	}
+
+	for _, tt := range tests {
+		t.Run(strings.Join(tt.in, "_"), func(t *testing.T) {
+			_, _, outbuf := zli.Test(t)
+			os.Args = append([]string{"testuni"}, tt.in...)
+			main()
+
+			if d := ztest.Diff(outbuf.String(), tt.want[1:]); d != "" {
+				t.Error(d)
+			}
+		})
+	}
+}
+
+func TestLint(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, data string) {
+		err := os.WriteFile(filepath.Join(dir, name), []byte(data), 0o644)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	write("a.go", "package main\n\n"+
+		"var p\u0430ypal = 1\n"+
+		"var s = \"a\u200bb\"\n"+
+		"/* \u202e } \u2066 */\n"+
+		"x := \"e\u0301\"\n"+
+		"bad \xff\x01\n"+
+		"var \u65e5\u672c\u30c6 = \"\U0001f468\u200d\U0001f469 \u2764\ufe0f \u0645\u06cc\u200c\u062e\"\r\n")
+	write("b.txt", "\ufeffhello\n")
+	write("allow", "# Comment\nU+200B\n*.txt bom\nbidi\n")
+	write("bin", "\x00\u202e")
+
+	tests := []struct {
+		in       []string
+		stdin    string
+		want     string
+		wantExit int
+	}{
+		{[]string{"lint", "-c", dir}, "", `
+a.go:3:6: warning: identifier "pаypal" mixes Latin and Cyrillic (mixed-script)
+a.go:4:11: warning: U+200B ZERO WIDTH SPACE (invisible)
+a.go:5:4: error: U+202E RIGHT-TO-LEFT OVERRIDE (bidi)
+a.go:5:8: error: U+2066 LEFT-TO-RIGHT ISOLATE (bidi)
+a.go:6:7: warning: not in NFC: U+0065 U+0301 should be U+00E9 (nfc)
+a.go:7:5: error: invalid UTF-8 byte 0xff (utf8)
+a.go:7:6: error: U+0001 START OF HEADING (control)
+b.txt:1:1: warning: U+FEFF ZERO WIDTH NO-BREAK SPACE (bom)
+`, 1},
+		{[]string{"lint", "-c", "-allow", filepath.Join(dir, "allow"), "-as", "json", filepath.Join(dir, "b.txt"), filepath.Join(dir, "a.go")}, "", `
+[{"path":"a.go","line":3,"col":6,"check":"mixed-script","severity":"warning","cpoint":"U+0430","name":"CYRILLIC SMALL LETTER A","message":"identifier \"pаypal\" mixes Latin and Cyrillic"},{"path":"a.go","line":6,"col":7,"check":"nfc","severity":"warning","cpoint":"U+0065","name":"LATIN SMALL LETTER E","message":"not in NFC: U+0065 U+0301 should be U+00E9"},{"path":"a.go","line":7,"col":5,"check":"utf8","severity":"error","message":"invalid UTF-8 byte 0xff"},{"path":"a.go","line":7,"col":6,"check":"control","severity":"error","cpoint":"U+0001","name":"START OF HEADING","message":"U+0001 START OF HEADING"}]
+`, 1},
+		{[]string{"lint"}, "x\u200by\n", `
+-:1:2: warning: U+200B ZERO WIDTH SPACE (invisible)
+uni lint: 1 problems in 1 files (0 errors, 1 warnings)
+`, 1},
+		{[]string{"lint", filepath.Join(dir, "bin")}, "", `
+bin:1:1: error: U+0000 NULL (control)
+bin:1:2: error: U+202E RIGHT-TO-LEFT OVERRIDE (bidi)
+uni lint: 2 problems in 1 files (2 errors, 0 warnings)
+`, 1},
+		{[]string{"lint", "-c", "-as", "sarif"}, "ok\n", "", 0},
+		{[]string{"lint", "-c", "-diff"},
+			"diff --git \"a/na\\303\\257ve.go\" \"b/na\\303\\257ve.go\"\n" +
+				"index 587be6b..8f8a59b 100644\n" +
+				"--- \"a/na\\303\\257ve.go\"\n" +
+				"+++ \"b/na\\303\\257ve.go\"\n" +
+				"@@ -1,3 +1,4 @@\n" +
+				" x\u200b\n" +
+				"-y\u200b\n" +
+				"+y\n" +
+				"+z\u200b\n" +
+				" \u202e\n" +
+				"--- /dev/null\n" +
+				"+++ b/new.txt\n" +
+				"@@ -0,0 +1,2 @@\n" +
+				"+\ufeffnew\n" +
+				"+\u202e\n", `
+naïve.go:3:2: warning: U+200B ZERO WIDTH SPACE (invisible)
+new.txt:1:1: warning: U+FEFF ZERO WIDTH NO-BREAK SPACE (bom)
+new.txt:2:1: error: U+202E RIGHT-TO-LEFT OVERRIDE (bidi)
+`, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(strings.Join(tt.in, "_"), func(t *testing.T) {
+			exit, in, out := zli.Test(t)
+			in.WriteString(tt.stdin)
+			os.Args = append([]string{"testuni"}, tt.in...)
+			func() {
+				defer exit.Recover()
+				main()
+			}()
+
+			have := strings.ReplaceAll(out.String(), dir+string(filepath.Separator), "")
+			if tt.want == "" {
+				if !strings.Contains(have, `"results": []`) && !strings.Contains(have, `"results":[]`) {
+					t.Errorf("no empty results in SARIF output:\n%s", have)
+				}
+			} else if d := ztest.Diff(have, tt.want[1:]); d != "" {
+				t.Error(d)
+			}
+			if int(*exit) != tt.wantExit && !(tt.wantExit == 0 && *exit == -1) {
+				t.Errorf("wrong exit: %d", *exit)
+			}
+		})
+	}
+}
+
+func TestClean(t *testing.T) {
+	tests := []struct {
+		in    []string
+		stdin string
+		want  string
+	}{
+		{[]string{"clean"}, "a\u200b\u00a0b\u202e\r\nc\u2028e\u0301 \ue000\x01 \U0001f468\u200d\U0001f469 \u2764\ufe0f\n", `
+a b
+c
+é � 👨‍👩 ❤️
+uni clean: removed 1× U+200B ZERO WIDTH SPACE
+uni clean: replaced 1× U+00A0 NO-BREAK SPACE → U+0020 SPACE
+uni clean: removed 1× U+202E RIGHT-TO-LEFT OVERRIDE
+uni clean: replaced 1× U+000D CARRIAGE RETURN (CR), U+000A LINE FEED (LF) → U+000A LINE FEED (LF)
+uni clean: replaced 1× U+2028 LINE SEPARATOR → U+000A LINE FEED (LF)
+uni clean: replaced 1× U+E000 <Private Use, First> → U+FFFD REPLACEMENT CHARACTER
+uni clean: removed 1× U+0001 START OF HEADING
+uni clean: normalized 1× U+0065 LATIN SMALL LETTER E, U+0301 COMBINING ACUTE ACCENT → U+00E9 LATIN SMALL LETTER E WITH ACUTE
+`},
+		{[]string{"clean", "-c", "-policy", "nfkc,spaces", "\ufb01\u00a0\u2460"}, "", `
+fi 1
+`},
+		{[]string{"clean", "-c", "-policy", "unassigned", "a\u0378b\U0003fffec\ue000"}, "", `
+a�b�c�
+`},
+		{[]string{"clean", "-q"}, "a\r\nb\r\n", `
+a
+b
+`},
+		{[]string{"clean", "-q"}, "a\nb", `
+a
+b`},
+	}
+
+	for _, tt := range tests {
+		t.Run(strings.Join(tt.in, "_"), func(t *testing.T) {
+			_, in, out := zli.Test(t)
+			in.WriteString(tt.stdin)
+			os.Args = append([]string{"testuni"}, tt.in...)
+			main()
+
+			if d := ztest.Diff(out.String(), tt.want[1:]); d != "" {
+				t.Error(d)
+			}
+		})
+	}
+}
+
+func TestVis(t *testing.T) {
+	tests := []struct {
+		in    []string
+		stdin string
+		want  string
+	}{
+		{[]string{"vis"}, "a\u00a0b\u200bc\u202e\r\n\x01\ufeff\tx\ue000 \U0001f468\u200d\U0001f469\n", `
+a⟨NBSP⟩b⟨ZWSP⟩c⟨RLO⟩⟨CR⟩
+⟨SOH⟩⟨BOM⟩	x⟨U+E000⟩ 👨‍👩
+`},
+		{[]string{"vis", "a\u0378b\U0003fffe"}, "", `
+a⟨U+0378⟩b⟨U+3FFFE⟩
+`},
+		{[]string{"vis", "-lookalikes", "p\u0430ypal\u00a0"}, "", `
+p⟨U+0430≈a⟩ypal⟨NBSP⟩
+`},
+	}
+
+	for _, tt := range tests {
+		t.Run(strings.Join(tt.in, "_"), func(t *testing.T) {
+			_, in, out := zli.Test(t)
+			in.WriteString(tt.stdin)
+			os.Args = append([]string{"testuni"}, tt.in...)
+			main()
+
+			if d := ztest.Diff(out.String(), tt.want[1:]); d != "" {
+				t.Error(d)
+			}
+		})
+	}
+}
+
+func TestCount(t *testing.T) {
+	tests := []struct {
+		in    []string
+		stdin string
+		want  string
+	}{
+		{[]string{"count", "h\u00e9llo \U0001f468\u200d\U0001f469\u200d\U0001f467"}, "", `
+utf-8       25
+utf-16      14
+codepoints  11
+graphemes   7
+cells       8
+`},
+		{[]string{"count", "-lines"}, "e\u0301\r\n\u4e16\tx\n", `
+line  utf-8  utf-16  codepoints  graphemes  cells
+   1      3       2           2          1      1
+   2      5       3           3          3      9
+`},
+		{[]string{"count", "-c"}, "\U0001f600\n\ta\n", `
+7 5 4 4 11
+`},
+		{[]string{"count", "-json", "-lines", "-c", "a\nb"}, "", `
+[{"line":1,"utf8":1,"utf16":1,"codepoints":1,"graphemes":1,"cells":1},{"line":2,"utf8":1,"utf16":1,"codepoints":1,"graphemes":1,"cells":1}]
+`},
+		{[]string{"count", "-truncate", "7:utf16", "ab\U0001f468\u200d\U0001f469\u200dc"}, "", `
+ab
+`},
+		{[]string{"count", "-truncate", "4:cells", "a\u4e16\u754cb"}, "", `
+a世
+`},
+		{[]string{"count", "-truncate", "2", "-lines"}, "e\u0301e\u0301e\u0301\nabc", "\ne\u0301e\u0301\nab\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(strings.Join(tt.in, "_"), func(t *testing.T) {
+			_, in, out := zli.Test(t)
+			in.WriteString(tt.stdin)
+			os.Args = append([]string{"testuni"}, tt.in...)
+			main()
+
+			if d := ztest.Diff(out.String(), tt.want[1:]); d != "" {
+				t.Error(d)
+			}
+		})
+	}
+}
+
+func TestDiff(t *testing.T) {
+	dir := t.TempDir()
+	os.WriteFile(dir+"/a", []byte("hello\nw\u00f6rld\n"), 0o644)
+	os.WriteFile(dir+"/b", []byte("hello\nwo\u0308rld\n"), 0o644)
+
+	tests := []struct {
+		in       []string
+		want     string
+		wantExit int
+	}{
+		{[]string{"diff", "p\u0430ypal\u00a0x\u200by", "paypal xy\ufb01"}, "" +
+			"1:2: confusable\n" +
+			"  - U+0430   \u0430   Ll  CYRILLIC SMALL LETTER A\n" +
+			"  + U+0061   a   Ll  LATIN SMALL LETTER A\n" +
+			"\n" +
+			"1:7: whitespace\n" +
+			"  - U+00A0   \u27e8NBSP\u27e9  Zs  NO-BREAK SPACE\n" +
+			"  + U+0020           Zs  SPACE\n" +
+			"\n" +
+			"1:9: invisible\n" +
+			"  - U+200B   \u27e8ZWSP\u27e9  Cf  ZERO WIDTH SPACE\n" +
+			"\n" +
+			"1:11 → 1:10: inserted\n" +
+			"  + U+FB01   \ufb01   Ll  LATIN SMALL LIGATURE FI\n", 1},
+		{[]string{"diff", "-files", "-c", dir + "/a", dir + "/b"}, "" +
+			"2:2: normalization\n" +
+			"  - U+00F6   \u00f6   Ll  LATIN SMALL LETTER O WITH DIAERESIS\n" +
+			"  + U+006F   o   Ll  LATIN SMALL LETTER O\n" +
+			"  + U+0308   \u25cc\u0308   Mn  COMBINING DIAERESIS\n", 1},
+		{[]string{"diff", "-json", "-c", "\ufb01x", "fix"}, "" +
+			`[{"kind":"compatibility","a":{"line":1,"col":1,"codepoints":[{"cpoint":"U+FB01","name":"LATIN SMALL LIGATURE FI","cat":"Ll"}]},` +
+			`"b":{"line":1,"col":1,"codepoints":[{"cpoint":"U+0066","name":"LATIN SMALL LETTER F","cat":"Ll"},{"cpoint":"U+0069","name":"LATIN SMALL LETTER I","cat":"Ll"}]}}]` + "\n", 1},
+		{[]string{"diff", "same", "same"}, "", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(strings.Join(tt.in, "_"), func(t *testing.T) {
+			exit, _, out := zli.Test(t)
+			os.Args = append([]string{"testuni"}, tt.in...)
+			func() {
+				defer exit.Recover()
+				main()
+			}()
+
+			if d := ztest.Diff(out.String(), tt.want); d != "" {
+				t.Error(d)
+			}
+			if int(*exit) != tt.wantExit && !(tt.wantExit == 0 && *exit == -1) {
+				t.Errorf("wrong exit: %d", *exit)
+			}
+		})
+	}
+}
+
+func TestStats(t *testing.T) {
+	tests := []struct {
+		in   []string
+		want string
+	}{
+		{[]string{"stats", "Hi \u0445\u0438 x\u200by"}, "" +
+			"Summary:\n" +
+			"Name        Count\n" +
+			"codepoints      9\n" +
+			"distinct        8\n" +
+			"\n" +
+			"Scripts:\n" +
+			"Name      Count  Percent\n" +
+			"Latin         4    44.4%\n" +
+			"Common        3    33.3%\n" +
+			"Cyrillic      2    22.2%\n" +
+			"\n" +
+			"Blocks:\n" +
+			"Name                 Count  Percent\n" +
+			"Basic Latin              6    66.7%\n" +
+			"Cyrillic                 2    22.2%\n" +
+			"General Punctuation      1    11.1%\n" +
+			"\n" +
+			"Categories:\n" +
+			"Short  Name              Count  Percent\n" +
+			"Ll     Lowercase_Letter      5    55.6%\n" +
+			"Zs     Space_Separator       2    22.2%\n" +
+			"Cf     Format                1    11.1%\n" +
+			"Lu     Uppercase_Letter      1    11.1%\n" +
+			"\n" +
+			"Properties:\n" +
+			"Name                 Count  Percent\n" +
+			"Pattern White Space      2    22.2%\n" +
+			"White Space              2    22.2%\n" +
+			"Soft Dotted              1    11.1%\n" +
+			"\n" +
+			"Unusual:\n" +
+			"CPoint          Count  Name\n" +
+			"U+200B  \u27e8ZWSP\u27e9      1  ZERO WIDTH SPACE\n"},
+		{[]string{"stats", "-as", "csv", "aa\u00e9"}, `
+section,short,cpoint,char,name,count,percent
+summary,,,,codepoints,3,
+summary,,,,distinct,2,
+scripts,,,,Latin,3,100.0%
+blocks,,,,Basic Latin,2,66.7%
+blocks,,,,Latin-1 Supplement,1,33.3%
+categories,Ll,,,Lowercase_Letter,3,100.0%
+properties,,,,ASCII Hex Digit,2,66.7%
+properties,,,,Hex Digit,2,66.7%
+`[1:]},
+	}
+
+	for _, tt := range tests {
+		t.Run(strings.Join(tt.in, "_"), func(t *testing.T) {
+			_, _, out := zli.Test(t)
+			os.Args = append([]string{"testuni"}, tt.in...)
+			main()
+
+			if d := ztest.Diff(out.String(), tt.want); d != "" {
+				t.Error(d)
+			}
+		})
+	}
+}
+
+func TestStatsParse(t *testing.T) {
+	t.Run("json", func(t *testing.T) {
+		_, _, out := zli.Test(t)
+		os.Args = []string{"testuni", "stats", "-as", "json", "a\u200bb"}
+		main()
+
+		var got map[string][]map[string]string
+		if err := json.Unmarshal(out.Bytes(), &got); err != nil {
+			t.Fatalf("%s\n%s", err, out)
+		}
+		var keys []string
+		for k := range got {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		want := []string{"blocks", "categories", "properties", "scripts", "summary", "unusual"}
+		if !reflect.DeepEqual(keys, want) {
+			t.Errorf("keys:\ngot:  %v\nwant: %v", keys, want)
+		}
+		if n := got["unusual"][0]["cpoint"]; n != "U+200B" {
+			t.Errorf("unusual: %q", n)
+		}
+	})
+
+	for _, as := range []string{"csv", "tsv"} {
+		t.Run(as, func(t *testing.T) {
+			_, _, out := zli.Test(t)
+			os.Args = []string{"testuni", "stats", "-as", as, "a\u200bb"}
+			main()
+
+			r := csv.NewReader(out)
+			if as == "tsv" {
+				r.Comma = '\t'
+			}
+			rows, err := r.ReadAll() // Also checks that all rows have the same number of fields.
+			if err != nil {
+				t.Fatalf("%s\n%s", err, out)
+			}
+			if rows[0][0] != "section" {
+				t.Errorf("header: %v", rows[0])
+			}
+			sections := make(map[string]int)
+			for _, r := range rows[1:] {
+				sections[r[0]]++
+			}
+			want := map[string]int{"summary": 2, "scripts": 2, "blocks": 2, "categories": 2, "properties": 2, "unusual": 1}
+			if !reflect.DeepEqual(sections, want) {
+				t.Errorf("\ngot:  %v\nwant: %v", sections, want)
+			}
+		})
+	}
+}
+
+func TestStyle(t *testing.T) {
+	tests := []struct {
+		in    []string
+		stdin string
+		want  string
+	}{
+		{[]string{"style", "bold", "Hi 42"}, "", "\U0001d407\U0001d422 \U0001d7d2\U0001d7d0\n"},
+		{[]string{"style", "italic", "h"}, "", "\u210e\n"},
+		{[]string{"style", "fraktur", "Ch"}, "", "\u212d\U0001d525\n"},
+		{[]string{"style", "squared", "ab"}, "", "\U0001f130\U0001f131\n"},
+		{[]string{"style", "small-caps", "Fix"}, "", "F\u026ax\n"},
+		{[]string{"style", "subscript", "2b"}, "", "\u2082b\n"},
+		{[]string{"style", "plain"}, "\U0001d407\U0001d422 \u2473 \u2122 \u0274\u1d0f\n", "Hi 20 TM no\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(strings.Join(tt.in, "_"), func(t *testing.T) {
+			_, in, out := zli.Test(t)
+			in.WriteString(tt.stdin)
+			os.Args = append([]string{"testuni"}, tt.in...)
+			main()
+
+			if d := ztest.Diff(out.String(), tt.want); d != "" {
+				t.Error(d)
+			}
+		})
+	}
+}
+
+func TestTranslit(t *testing.T) {
+	tests := []struct {
+		in   []string
+		want string
+	}{
+		{[]string{"translit", "Æther café, €5½"}, "AEther cafe, EUR5 1/2\n"},
+		{[]string{"translit", "Щука Αθήνα ✓"}, "Suka Athina ?\n"},
+		{[]string{"translit", "-scheme", "iso9", "Щука, Їжак"}, "\u015cuka, \u00cf\u017eak\n"},
+		{[]string{"translit", "-scheme", "elot", "Ευαγγέλιο ΑΘΗΝΑ Μπαμπάς"}, "Evang\u00e9lio ATHINA Bamp\u00e1s\n"},
+		{[]string{"translit", "-scheme", "hepburn", "きって しんよう ラーメン マッチ"}, "kitte shin'you r\u0101men matchi\n"},
+		{[]string{"translit", "-scheme", "pinyin", "北京 abc"}, "b\u011bi j\u012bng abc\n"},
+		{[]string{"i", "é½✓", "-q", "-f", "%(char) %(ascii)"}, "\u00e9 e\n\u00bd 1/2\n\u2713 \n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(strings.Join(tt.in, "_"), func(t *testing.T) {
+			_, _, out := zli.Test(t)
+			os.Args = append([]string{"testuni"}, tt.in...)
+			main()
+
+			if d := ztest.Diff(out.String(), tt.want); d != "" {
+				t.Error(d)
+			}
+		})
+	}
+}
+
+func TestTransform(t *testing.T) {
+	tests := []struct {
+		in    []string
+		stdin string
+		want  string
+	}{
+		{[]string{"transform", "narrow,fullwidth"}, "\uff21\u3000\uff76\uff9e\uff71\uff9e\n", `
+A ガア゛
+uni transform: replaced 1× U+FF21 FULLWIDTH LATIN CAPITAL LETTER A → U+0041 LATIN CAPITAL LETTER A
+uni transform: replaced 1× U+3000 IDEOGRAPHIC SPACE → U+0020 SPACE
+uni transform: replaced 1× U+FF76 HALFWIDTH KATAKANA LETTER KA, U+FF9E HALFWIDTH KATAKANA VOICED SOUND MARK → U+30AC KATAKANA LETTER GA
+uni transform: replaced 1× U+FF71 HALFWIDTH KATAKANA LETTER A → U+30A2 KATAKANA LETTER A
+uni transform: replaced 1× U+FF9E HALFWIDTH KATAKANA VOICED SOUND MARK → U+309B KATAKANA-HIRAGANA VOICED SOUND MARK
+`},
+		{[]string{"transform", "-c", "wide", "a1"}, "", "\n\uff41\uff11\n"},
+		{[]string{"transform", "-c", "katakana,halfwidth", "\u304c\u3063\u3053\u3046"}, "", "\n\uff76\uff9e\uff6f\uff7a\uff73\n"},
+		{[]string{"transform", "-c", "hiragana", "\u30ab\u30bf\u30ab\u30ca\u30fd abc"}, "", "\n\u304b\u305f\u304b\u306a\u309d abc\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(strings.Join(tt.in, "_"), func(t *testing.T) {
+			_, in, out := zli.Test(t)
+			in.WriteString(tt.stdin)
+			os.Args = append([]string{"testuni"}, tt.in...)
+			main()
+
+			if d := ztest.Diff(out.String(), tt.want[1:]); d != "" {
+				t.Error(d)
+			}
+		})
+	}
+}
+
+func TestZh(t *testing.T) {
+	tests := []struct {
+		in   []string
+		want string
+	}{
+		{[]string{"zh", "-to", "traditional", "头发 台 abc"}, `
+頭發 台 abc
+uni zh: replaced 1× U+5934 <CJK Ideograph> (头) → U+982D <CJK Ideograph> (頭)
+uni zh: ambiguous 1× U+53D1 <CJK Ideograph> (发) → U+767C <CJK Ideograph> (發), U+9AEE <CJK Ideograph> (髮)
+uni zh: ambiguous 1× U+53F0 <CJK Ideograph> (台) → U+53F0 <CJK Ideograph> (台), U+6AAF <CJK Ideograph> (檯), U+81FA <CJK Ideograph> (臺), U+98B1 <CJK Ideograph> (颱)
+`},
+		{[]string{"zh", "-c", "-to", "simp", "頭髮 萬里 abc"}, `
+头发 万里 abc
+`},
+		{[]string{"i", "-c", "萬台", "-f", "%(char) %(simplified) %(traditional) %(variants)"}, `
+萬 万  万
+台  台檯臺颱 檯臺颱
+`},
+	}
+
+	for _, tt := range tests {
+		t.Run(strings.Join(tt.in, "_"), func(t *testing.T) {
+			_, _, out := zli.Test(t)
+			os.Args = append([]string{"testuni"}, tt.in...)
+			main()
+
+			if d := ztest.Diff(out.String(), tt.want[1:]); d != "" {
+				t.Error(d)
+			}
+		})
+	}
+}
+
+func TestIDNA(t *testing.T) {
+	tests := []struct {
+		in       []string
+		want     string
+		wantExit int
+	}{
+		{[]string{"idna", "Bücher.EX"}, `
+input:    Bücher.EX
+unicode:  bücher.ex
+ascii:    xn--bcher-kva.ex
+mapped:   U+0042 LATIN CAPITAL LETTER B → U+0062 LATIN SMALL LETTER B
+mapped:   U+0045 LATIN CAPITAL LETTER E → U+0065 LATIN SMALL LETTER E
+mapped:   U+0058 LATIN CAPITAL LETTER X → U+0078 LATIN SMALL LETTER X
+labels:
+  bücher  xn--bcher-kva
+  ex      ex
+`, 0},
+		{[]string{"idna", "-c", "xn--bcher-kva.example", "ÉCOLE.fr"}, `
+bücher.example xn--bcher-kva.example
+école.fr xn--cole-9oa.fr
+`, 0},
+		{[]string{"idna", "раураl.com"}, `
+input:    раураl.com
+unicode:  раураl.com
+ascii:    xn--l-7sba6dbr.com
+labels:
+  раураl  xn--l-7sba6dbr
+    warning: mixed scripts: Cyrillic, Latin
+    warning: confusable with "paypal"
+  com     com
+`, 0},
+		{[]string{"idna", "a_b.com"}, `
+input:    a_b.com
+unicode:  a_b.com
+ascii:    a_b.com
+labels:
+  a_b  a_b
+    error: U+005F LOW LINE is not allowed in host names (STD3)
+  com  com
+`, 1},
+		{[]string{"idna", "-c", "a\u200cb.com"}, `
+a‌b.com xn--ab-j1t.com
+`, 1},
+		// U+0CF3 is new in Unicode 15.0.
+		{[]string{"idna", "-c", "ಕೳ.com"}, `
+ಕೳ.com xn--nsc2q.com
+`, 0},
+		{[]string{"idna", "--", "-ab.com"}, `
+input:    -ab.com
+unicode:  -ab.com
+ascii:    -ab.com
+labels:
+  -ab  -ab
+    error: starts or ends with a hyphen
+  com  com
+`, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(strings.Join(tt.in, "_"), func(t *testing.T) {
+			exit, _, out := zli.Test(t)
+			os.Args = append([]string{"testuni"}, tt.in...)
+			func() {
+				defer exit.Recover()
+				main()
+			}()
+
+			if d := ztest.Diff(out.String(), tt.want[1:]); d != "" {
+				t.Error(d)
+			}
+			if int(*exit) != tt.wantExit && !(tt.wantExit == 0 && *exit == -1) {
+				t.Errorf("wrong exit: %d", *exit)
+			}
+		})
+	}
+}
+
+func TestPRECIS(t *testing.T) {
+	tests := []struct {
+		in       []string
+		want     string
+		wantExit int
+	}{
+		{[]string{"precis", "Ｊｕｌｉｅｔ", "ΟΔΥΣΣΕΥΣ"}, `
+input:       Ｊｕｌｉｅｔ
+output:      juliet
+
+input:       ΟΔΥΣΣΕΥΣ
+output:      οδυσσευσ
+`, 0},
+		{[]string{"precis", "-profile", "usernamecasepreserved", "Juliet♥", "a b", "ab・"}, `
+input:       Juliet♥
+disallowed:  U+2665 BLACK HEART SUIT (ID_DIS or FREE_PVAL)
+
+input:       a b
+disallowed:  U+0020 SPACE (ID_DIS or FREE_PVAL)
+
+input:       ab・
+disallowed:  U+30FB KATAKANA MIDDLE DOT (CONTEXTO)
+`, 1},
+		{[]string{"precis", "-profile", "opaquestring", "Correct Horse　♥", ""}, `
+input:       Correct Horse　♥
+output:      Correct Horse ♥
+
+input:       
+error:       empty string
+`, 1},
+		{[]string{"precis", "-c", "-profile", "nick", "  Foo   Bar ", "⑴​"}, `
+Foo Bar
+`, 1},
+		{[]string{"i", "-c", "aA ·‌", "-f", "%(cpoint) %(precis)"}, `
+U+0061 PVALID
+U+0041 PVALID
+U+0020 ID_DIS or FREE_PVAL
+U+00B7 CONTEXTO
+U+200C CONTEXTJ
+`, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(strings.Join(tt.in, "_"), func(t *testing.T) {
+			exit, _, out := zli.Test(t)
+			os.Args = append([]string{"testuni"}, tt.in...)
+			func() {
+				defer exit.Recover()
+				main()
+			}()
+
+			if d := ztest.Diff(out.String(), tt.want[1:]); d != "" {
+				t.Error(d)
+			}
+			if int(*exit) != tt.wantExit && !(tt.wantExit == 0 && *exit == -1) {
+				t.Errorf("wrong exit: %d", *exit)
+			}
+		})
+	}
+}
+
+func TestIdent(t *testing.T) {
+	tests := []struct {
+		in       []string
+		want     string
+		wantExit int
+	}{
+		{[]string{"ident", "foo", "1x", "x-y"}, `
+input:    foo
+valid:    yes
+
+input:    1x
+valid:    no
+error:    U+0031 DIGIT ONE at position 1: not XID_Start
+
+input:    x-y
+valid:    no
+error:    U+002D HYPHEN-MINUS at position 2: not XID_Continue
+`, 1},
+		{[]string{"ident", "-profile", "go", "_x", "x·y"}, `
+input:    _x
+valid:    yes
+
+input:    x·y
+valid:    no
+error:    U+00B7 MIDDLE DOT at position 2: not a letter, decimal digit, or _ (category Po)
+`, 1},
+		{[]string{"ident", "-profile", "rust", "_", "caf\u0065\u0301"}, `
+input:    _
+valid:    no
+error:    _ on its own is not an identifier
+
+input:    café
+valid:    yes
+warning:  same identifier as "café" (NFC)
+`, 1},
+		{[]string{"ident", "-profile", "python", "ﬁle"}, `
+input:    ﬁle
+valid:    yes
+warning:  same identifier as "file" (NFKC)
+`, 0},
+		{[]string{"ident", "-c", "-profile", "javascript", "$el", "a·b", "#x"}, `
+$el
+a·b
+`, 1},
+		{[]string{"ident", "-profile", "restricted", "paypаl", "ǅx"}, `
+input:    paypаl
+valid:    no
+error:    mixes Latin and Cyrillic from position 5, which isn't allowed in the Highly Restrictive level
+
+input:    ǅx
+valid:    no
+error:    U+01C5 LATIN CAPITAL LETTER D WITH SMALL LETTER Z WITH CARON at position 1: Identifier_Status is Restricted (Identifier_Type Not_NFKC)
+`, 1},
+		{[]string{"ident", "-profile", "restricted", "aꞵ", "a-b"}, `
+input:    aꞵ
+valid:    no
+error:    U+A7B5 LATIN SMALL LETTER BETA at position 2: Identifier_Status is Restricted (Identifier_Type Uncommon_Use)
+
+input:    a-b
+valid:    no
+error:    U+002D HYPHEN-MINUS at position 2: not XID_Continue
+`, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(strings.Join(tt.in, "_"), func(t *testing.T) {
+			exit, _, out := zli.Test(t)
+			os.Args = append([]string{"testuni"}, tt.in...)
+			func() {
+				defer exit.Recover()
+				main()
+			}()
+
+			if d := ztest.Diff(out.String(), tt.want[1:]); d != "" {
+				t.Error(d)
+			}
+			if int(*exit) != tt.wantExit && !(tt.wantExit == 0 && *exit == -1) {
+				t.Errorf("wrong exit: %d", *exit)
+			}
+		})
+	}
+}
+
+func TestOrder(t *testing.T) {
+	tests := []struct {
+		in   []string
+		want string
+	}{
+		{[]string{"p", "U+2191", "U+02C3", "U+2190", "U+02C2", "-group-by", "block", "-limit", "1", "-c", "-f", "%(char) %(cpoint)"}, `
+Block Spacing Modifier Letters (1 of 2)
+˂ U+02C2
+Block Arrows (1 of 2)
+← U+2190
+`},
+		{[]string{"p", "U+2600..U+2610", "-sort", "name", "-reverse", "-limit", "3", "-offset", "1", "-c", "-f", "%(char) %(name)"}, `
+☆ WHITE STAR
+☂ UMBRELLA
+☈ THUNDERSTORM
+`},
+		{[]string{"i", "a€b", "-sort", "age", "-reverse", "-c", "-f", "%(char) %(age)"}, `
+€ 2.1
+b 1.1
+a 1.1
+`},
+		{[]string{"e", "cat", "-group-by", "group", "-sort", "name", "-limit", "1", "-c", "-f", "%(emoji) %(name)"}, `
+Group Symbols (1)
+🈸 Japanese “application” button
+Group Animals & Nature (1 of 3)
+🐈‍⬛ black cat
+Group Smileys & Emotion (1 of 9)
+😹 cat with tears of joy
+Group Objects (1)
+🪪 identification card
+`},
+		{[]string{"list", "scripts", "-sort", "name", "-limit", "3", "-offset", "2", "-c"}, `
+Anatolian Hieroglyphs     582
+Arabic                   1280
+Armenian                   87
+`},
+	}
+
+	for _, tt := range tests {
+		t.Run(strings.Join(tt.in, "_"), func(t *testing.T) {
+			_, _, outbuf := zli.Test(t)
+			os.Args = append([]string{"testuni"}, tt.in...)
+			main()
+
+			if d := ztest.Diff(outbuf.String(), tt.want[1:]); d != "" {
+				t.Error(d)
+			}
+		})
+	}
+}
+
+func TestNDJSON(t *testing.T) {
+	tests := []struct {
+		in   []string
+		want string
+	}{
+		{[]string{"i", "ʼ€", "-as", "ndjson", "-f", "%(char) %(dec) %(utf8) %(props)"}, `
+{"char":"ʼ","dec":"700","props":"Diacritic","utf8":"ca bc"}
+{"char":"€","dec":"8364","props":"","utf8":"e2 82 ac"}
+`},
+		{[]string{"i", "ʼ€", "-as", "ndjson", "-typed", "-f", "%(char) %(cpoint) %(dec) %(utf8) %(props)"}, `
+{"char":"ʼ","cpoint":700,"dec":700,"props":["Diacritic"],"utf8":[202,188]}
+{"char":"€","cpoint":8364,"dec":8364,"props":[],"utf8":[226,130,172]}
+`},
+		{[]string{"s", "roman numeral fifty", "-as", "ndjson", "-f", "%(cpoint)"}, `
+{"cpoint":"U+216C"}
+{"cpoint":"U+217C"}
+{"cpoint":"U+2186"}
+{"cpoint":"U+2187"}
+`},
+		{[]string{"e", "-as", "json", "-c", "-typed", "-f", "%(emoji) %(cpoint) %(cldr)", "shrug"}, `
+[{"cldr":["doubt","ignorance","indifference"],"cpoint":[129335],"emoji":"🤷"}]
+`},
+	}
+
+	for _, tt := range tests {
+		t.Run(strings.Join(tt.in, "_"), func(t *testing.T) {
+			_, _, outbuf := zli.Test(t)
+			os.Args = append([]string{"testuni"}, tt.in...)
+			main()
+
+			if d := ztest.Diff(outbuf.String(), tt.want[1:]); d != "" {
+				t.Error(d)
+			}
+		})
+	}
+}
+
+func TestCard(t *testing.T) {
+	tests := []struct {
+		in   []string
+		want string
+	}{
+		{[]string{"i", "€", "-as", "card", "-c"}, `
+€  U+20AC  EURO SIGN
+  Encodings
+    Decimal     8364
+    Hex         20ac
+    Octal       20254
+    Binary      10000010101100
+    UTF-8       e2 82 ac
+    UTF-16 BE   20 ac
+    UTF-16 LE   ac 20
+    JSON        \u20ac
+    XML         &#x20ac;
+  Names
+    Name        EURO SIGN
+  Classification
+    Category    Sc (Currency_Symbol)
+    Script      Common
+    Block       Currency Symbols (U+20A0..U+20CF)
+    Plane       Basic Multilingual Plane
+    Width       ambiguous
+    Age         2.1
+  Input
+    HTML        &euro;
+    Keysym      EuroSign
+    Digraph     =e
+`},
+		{[]string{"p", "U+0085", "-as", "card"}, `
+␣  U+0085  NEXT LINE (NEL)
+
+  Encodings
+    Decimal     133
+    Hex         85
+    Octal       205
+    Binary      10000101
+    UTF-8       c2 85
+    UTF-16 BE   00 85
+    UTF-16 LE   85 00
+    JSON        \u0085
+    XML         &#x85;
+
+  Names
+    Name        NEXT LINE (NEL)
+    Aliases     NEXT LINE, NEL
+
+  Classification
+    Category    Cc (Control)
+    Script      Common
+    Block       Latin-1 Supplement (U+0080..U+00FF)
+    Plane       Basic Multilingual Plane
+    Width       narrow
+    Age         1.1
+
+  Properties
+    Pattern White Space
+    White Space
+
+  Input
+    HTML        &#x85;
+    Digraph     NL
+`},
+	}
+
+	for _, tt := range tests {
+		t.Run(strings.Join(tt.in, "_"), func(t *testing.T) {
+			_, _, outbuf := zli.Test(t)
+			os.Args = append([]string{"testuni"}, tt.in...)
+			main()
+
+			if d := ztest.Diff(outbuf.String(), tt.want[1:]); d != "" {
+				t.Error(d)
+			}
+		})
+	}
+}
+
+func TestMarkdownHTML(t *testing.T) {
+	tests := []struct {
+		in   []string
+		want string
+	}{
+		{[]string{"i", "a|€", "-as", "md", "-f", "%(char)%(wide_padding) %(cpoint r:auto) %(name)"}, `
+| Char | CPoint | Name                 |
+| ---- | -----: | -------------------- |
+| a    | U+0061 | LATIN SMALL LETTER A |
+| \|   | U+007C | VERTICAL LINE        |
+| €    | U+20AC | EURO SIGN            |
+`},
+		{[]string{"i", "a€", "-as", "md", "-c", "-f", "%(cpoint) %(name)"}, `
+| CPoint | Name |
+| --- | --- |
+| U+0061 | LATIN SMALL LETTER A |
+| U+20AC | EURO SIGN |
+`},
+		{[]string{"i", "a<", "-as", "html", "-f", "%(char) %(name)"}, `
+<table>
+<thead><tr><th>Char</th><th>Name</th></tr></thead>
+<tbody>
+<tr><td>a</td><td>LATIN SMALL LETTER A</td></tr>
+<tr><td>&lt;</td><td>LESS-THAN SIGN</td></tr>
+</tbody>
+</table>
+`},
+	}
+
+	for _, tt := range tests {
+		t.Run(strings.Join(tt.in, "_"), func(t *testing.T) {
+			_, _, outbuf := zli.Test(t)
+			os.Args = append([]string{"testuni"}, tt.in...)
+			main()
+
+			if d := ztest.Diff(outbuf.String(), tt.want[1:]); d != "" {
+				t.Error(d)
+			}
+		})
+	}
+}
+
+func TestChart(t *testing.T) {
+	_, _, outbuf := zli.Test(t)
+	os.Args = []string{"testuni", "p", "U+20AC..U+20C0", "-as", "chart"}
+	main()
+
+	got := outbuf.String()
+	for _, want := range []string{
+		"<title>Currency Symbols</title>",
+		`<text class="head" x="40" y="50">U+20Ax</text>`,
+		`<g><title>U+20AC EURO SIGN</title>`,
+		`<g class="other"><title>U+20A0 EURO-CURRENCY SIGN</title>`,
+		`<g class="unassigned"><title>U+20CF &lt;unassigned&gt;</title>`,
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("doesn't contain %q", want)
+		}
+	}
+
+	// Codepoints that are defined as a range are assigned.
+	_, _, outbuf = zli.Test(t)
+	os.Args = []string{"testuni", "p", "U+4E00", "-as", "chart"}
+	main()
+	if want := `<g class="other"><title>U+4E01 &lt;CJK Ideograph&gt;</title>`; !strings.Contains(outbuf.String(), want) {
+		t.Errorf("doesn't contain %q", want)
+	}
+}
+
 func BenchmarkUni(b *testing.B) {
 	zli.Stdout = new(bytes.Buffer)
 
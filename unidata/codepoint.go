@@ -3,8 +3,10 @@ package unidata
 import (
 	"errors"
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"unicode/utf16"
 	"unicode/utf8"
 )
@@ -25,21 +27,23 @@ type (
 		name     string
 	}
 
-	Width        uint8      // Unicode width
-	Plane        uint8      // Unicode plane
-	Category     uint8      // Unicode category
-	Block        uint16     // Unicode block
-	Script       uint16     // Unicode script.
-	Property     uint8      // Unicode property
-	PropertyList []Property // Unicode property
+	Width           uint8      // Unicode width
+	Plane           uint8      // Unicode plane
+	Category        uint8      // Unicode category
+	Block           uint16     // Unicode block
+	Script          uint16     // Unicode script.
+	Property        uint8      // Unicode property
+	PropertyList    []Property // Unicode property
+	DerivedProperty uint8      // Unicode derived core property
 )
 
-func (w Width) String() string    { return Widths[w] }
-func (c Category) String() string { return Categories[c].Name }
-func (p Plane) String() string    { return Planes[p].Name }
-func (b Block) String() string    { return Blocks[b].Name }
-func (s Script) String() string   { return Scripts[s].Name }
-func (p Property) String() string { return Properties[p].Name }
+func (w Width) String() string           { return Widths[w] }
+func (c Category) String() string        { return Categories[c].Name }
+func (p Plane) String() string           { return Planes[p].Name }
+func (b Block) String() string           { return Blocks[b].Name }
+func (s Script) String() string          { return Scripts[s].Name }
+func (p Property) String() string        { return Properties[p].Name }
+func (p DerivedProperty) String() string { return DerivedProperties[p].Name }
 func (p PropertyList) String() string {
 	var b strings.Builder
 	for i, pp := range p {
@@ -187,6 +191,68 @@ func Find(cp rune) (Codepoint, bool) {
 	return Codepoint{Codepoint: cp, name: "CODEPOINT NOT IN UNICODE"}, false
 }
 
+// Assigned gets the ranges of all assigned codepoints, including codepoints
+// that aren't listed individually in Codepoints (such as CJK ideographs).
+func Assigned() [][2]rune {
+	return rangesOf(func(Codepoint) bool { return true })
+}
+
+// Ranges gets the ranges of all codepoints in this category. For categories
+// such as CatLetter this includes all the categories it's composed of.
+func (c Category) Ranges() [][2]rune {
+	if c == CatUnassigned {
+		var (
+			unassigned = make([][2]rune, 0, 1024)
+			next       rune
+		)
+		for _, r := range Assigned() {
+			if r[0] > next {
+				unassigned = append(unassigned, [2]rune{next, r[0] - 1})
+			}
+			next = r[1] + 1
+		}
+		if next <= 0x10ffff {
+			unassigned = append(unassigned, [2]rune{next, 0x10ffff})
+		}
+		return unassigned
+	}
+
+	return rangesOf(func(cp Codepoint) bool { return cp.in(c) })
+}
+
+// rangesOf gets the ranges of all assigned codepoints for which match returns
+// true.
+func rangesOf(match func(Codepoint) bool) [][2]rune {
+	rng := make([][2]rune, 0, 256)
+	for cp, info := range Codepoints {
+		if match(info) {
+			rng = append(rng, [2]rune{cp, cp})
+		}
+	}
+	for _, r := range codepointRanges {
+		if match(Codepoints[r.rng[0]]) {
+			rng = append(rng, r.rng)
+		}
+	}
+	if len(rng) == 0 {
+		return nil
+	}
+
+	sort.Slice(rng, func(i, j int) bool { return rng[i][0] < rng[j][0] })
+	merged := rng[:1]
+	for _, r := range rng[1:] {
+		last := &merged[len(merged)-1]
+		if r[0] <= last[1]+1 {
+			if r[1] > last[1] {
+				last[1] = r[1]
+			}
+			continue
+		}
+		merged = append(merged, r)
+	}
+	return merged
+}
+
 // FromString gets a codepoint from human input.
 //
 // The input can be as (case-insensitive):
@@ -232,6 +298,139 @@ func FromString(s string) (Codepoint, error) {
 	return cp, nil
 }
 
+var (
+	reverseOnce     sync.Once
+	reverseHTML     map[string]rune
+	reverseKeySym   map[string]rune
+	reverseDigraphs map[string]rune
+	reverseNames    map[string]rune
+)
+
+func loadReverse() {
+	reverseHTML = make(map[string]rune, len(htmlEntities)*2)
+	for cp, ents := range htmlEntities {
+		for _, e := range ents {
+			reverseHTML[e] = cp
+		}
+	}
+	reverseKeySym = make(map[string]rune, len(keysyms))
+	for cp, k := range keysyms {
+		reverseKeySym[k] = cp
+	}
+	reverseDigraphs = make(map[string]rune, len(digraphs)+len(digraphsAlt))
+	for cp, d := range digraphs {
+		reverseDigraphs[d] = cp
+	}
+	for d, cp := range digraphsAlt {
+		reverseDigraphs[d] = cp
+	}
+	reverseNames = make(map[string]rune, len(Codepoints))
+	for cp, c := range Codepoints {
+		// U+1180 HANGUL JUNGSEONG O-E has the same loose name as U+116C
+		// HANGUL JUNGSEONG OE; FromName deals with it.
+		if cp != 0x1180 {
+			reverseNames[looseName(c.name)] = cp
+		}
+	}
+}
+
+// FromHTML finds a codepoint by HTML entity name.
+//
+// The name is case-sensitive, and may optionally be given as an entity (e.g.
+// both "euro" and "&euro;" work). Numeric entities such as "&#x20ac;" and
+// "&#8364;" are also accepted.
+func FromHTML(name string) (Codepoint, bool) {
+	name = strings.TrimSuffix(strings.TrimPrefix(name, "&"), ";")
+	if strings.HasPrefix(name, "#") {
+		base, n := 10, name[1:]
+		if strings.HasPrefix(n, "x") || strings.HasPrefix(n, "X") {
+			base, n = 16, n[1:]
+		}
+		i, err := strconv.ParseInt(n, base, 32)
+		if err != nil {
+			return Codepoint{}, false
+		}
+		return Find(rune(i))
+	}
+
+	reverseOnce.Do(loadReverse)
+	cp, ok := reverseHTML[name]
+	if !ok {
+		return Codepoint{}, false
+	}
+	return Find(cp)
+}
+
+// FromKeySym finds a codepoint by X11 keysym name (e.g. "EuroSign"). The name
+// is case-sensitive.
+func FromKeySym(name string) (Codepoint, bool) {
+	reverseOnce.Do(loadReverse)
+	cp, ok := reverseKeySym[strings.TrimPrefix(name, "XK_")]
+	if !ok {
+		return Codepoint{}, false
+	}
+	return Find(cp)
+}
+
+// FromDigraph finds a codepoint by digraph (e.g. "Eu" or "=e"). The digraph is
+// case-sensitive.
+func FromDigraph(digraph string) (Codepoint, bool) {
+	reverseOnce.Do(loadReverse)
+	cp, ok := reverseDigraphs[digraph]
+	if !ok {
+		return Codepoint{}, false
+	}
+	return Find(cp)
+}
+
+// FromName finds a codepoint by name (e.g. "EURO SIGN").
+//
+// This uses the "loose matching" rules from UAX44-LM2: case, whitespace,
+// underscores, and medial hyphens are ignored, so "euro sign", "EuroSign" and
+// "euro_sign" all work.
+func FromName(name string) (Codepoint, bool) {
+	match := looseName(name)
+	if match == "" {
+		return Codepoint{}, false
+	}
+
+	// U+1180 HANGUL JUNGSEONG O-E is the only name where the medial hyphen is
+	// significant, as it would otherwise be identical to U+116C HANGUL
+	// JUNGSEONG OE.
+	if match == "hanguljungseongoe" && strings.Contains(name, "-") {
+		return Find(0x1180)
+	}
+
+	reverseOnce.Do(loadReverse)
+	cp, ok := reverseNames[match]
+	if !ok {
+		return Codepoint{}, false
+	}
+	return Find(cp)
+}
+
+// looseName normalizes a name according to UAX44-LM2.
+func looseName(name string) string {
+	var (
+		b     strings.Builder
+		r     = []rune(name)
+		isAln = func(r rune) bool {
+			return (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9')
+		}
+	)
+	b.Grow(len(name))
+	for i, c := range r {
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '_':
+			continue
+		case c == '-' && i > 0 && i < len(r)-1 && isAln(r[i-1]) && isAln(r[i+1]):
+			continue
+		}
+		b.WriteRune(c)
+	}
+	return strings.ToLower(b.String())
+}
+
 func (c Codepoint) String() string {
 	return c.Display() + ": " + c.FormatCodepoint() + " " + c.name
 }
@@ -272,6 +471,28 @@ func (c Codepoint) Display() string {
 // Name gets the name for this codepoint.
 func (c Codepoint) Name() string { return c.name }
 
+// Aliases gets the formal name aliases for this codepoint, such as corrections
+// to the name and abbreviations. The name from Name() is never included.
+func (c Codepoint) Aliases() []string {
+	a := make([]string, 0, len(nameAliases[c.Codepoint]))
+	for _, n := range nameAliases[c.Codepoint] {
+		if n != c.name {
+			a = append(a, n)
+		}
+	}
+	return a
+}
+
+// Age gets the Unicode version this codepoint was first assigned in, such as
+// "1.1" or "15.0". This is an empty string for unassigned codepoints.
+func (c Codepoint) Age() string {
+	i := sort.Search(len(ages), func(i int) bool { return ages[i].rng[1] >= c.Codepoint })
+	if i < len(ages) && ages[i].rng[0] <= c.Codepoint {
+		return ages[i].age
+	}
+	return ""
+}
+
 // Width gets this codepoint's width.
 func (c Codepoint) Width() Width { return c.width }
 
@@ -380,12 +601,27 @@ func (c Codepoint) XML() string { return "&#x" + strconv.FormatInt(int64(c.Codep
 // HTML formats the codepoint as an HTML entity, prefering a symbolic name if it
 // exists (e.g. &amp; instead of &#x26;)
 func (c Codepoint) HTML() string {
-	if h := htmlEntities[c.Codepoint]; h != "" {
-		return "&" + h + ";"
+	if h := htmlEntities[c.Codepoint]; len(h) > 0 {
+		return "&" + h[0] + ";"
 	}
 	return c.XML()
 }
 
+// HTMLAll gets all HTML entities for this codepoint; the first one is the same
+// as what HTML() returns. This will be the XML entity if there are no named
+// entities.
+func (c Codepoint) HTMLAll() []string {
+	h := htmlEntities[c.Codepoint]
+	if len(h) == 0 {
+		return []string{c.XML()}
+	}
+	all := make([]string, 0, len(h))
+	for _, e := range h {
+		all = append(all, "&"+e+";")
+	}
+	return all
+}
+
 // KeySym gets the X11 keysym name.
 func (c Codepoint) KeySym() string { return keysyms[c.Codepoint] }
 
@@ -398,6 +634,47 @@ func (c Codepoint) KeySym() string { return keysyms[c.Codepoint] }
 //   =R    ₽   U+20BD RUBLE SIGN
 func (c Codepoint) Digraph() string { return digraphs[c.Codepoint] }
 
+// Confusable gets the characters this codepoint can be confused with, as the
+// "prototype" from Unicode confusables.txt (e.g. "a" for U+0430 CYRILLIC SMALL
+// LETTER A). This is an empty string if it's not confusable with anything.
+func (c Codepoint) Confusable() string { return confusables[c.Codepoint] }
+
+// LatinASCII gets the ASCII replacement for Latin letters and punctuation that
+// can't be decomposed to ASCII, such as "AE" for Æ or "'" for ’. This is an
+// empty string if there isn't any.
+func (c Codepoint) LatinASCII() string { return latinASCII[c.Codepoint] }
+
+// Pinyin gets the most common Mandarin reading of a CJK ideograph in Hanyu
+// Pinyin, with tone marks (e.g. "zhōng" for 中). This is an empty string if
+// it's not known.
+func (c Codepoint) Pinyin() string { return pinyin[c.Codepoint] }
+
+// Simplified gets the simplified Chinese variants of a traditional CJK
+// ideograph (e.g. "万" for 萬). There can be more than one, and this can
+// include the codepoint itself if it's also used in simplified Chinese.
+func (c Codepoint) Simplified() string { return simplified[c.Codepoint] }
+
+// Traditional gets the traditional Chinese variants of a simplified CJK
+// ideograph (e.g. "萬" for 万). There can be more than one, and this can
+// include the codepoint itself if it's also used in traditional Chinese.
+func (c Codepoint) Traditional() string { return traditional[c.Codepoint] }
+
+// Variants gets all variants of a CJK ideograph: the simplified and
+// traditional variants, Z-variants (same character, different glyph), and
+// semantic variants (same meaning). The codepoint itself is never included.
+func (c Codepoint) Variants() string {
+	b := new(strings.Builder)
+	for _, s := range []string{simplified[c.Codepoint], traditional[c.Codepoint],
+		zVariants[c.Codepoint], semanticVariants[c.Codepoint]} {
+		for _, r := range s {
+			if r != c.Codepoint && !strings.ContainsRune(b.String(), r) {
+				b.WriteRune(r)
+			}
+		}
+	}
+	return b.String()
+}
+
 // in reports if this codepoint is in the given category.
 //
 // TODO: this is a bit ugly; we should generate this data better.
@@ -0,0 +1,24 @@
+BEGIN        { FS = " *[;#] *"
+               PROCINFO["sorted_in"] = "@ind_num_asc"
+             }
+/^$/ || /^#/ { next }
+
+{
+    split($1, se, /\.\./)
+    start = strtonum("0x" se[1])
+    end   = se[2] == "" ? start : strtonum("0x" se[2])
+    ages[start] = sprintf("{[2]rune{0x%04X, 0x%04X}, \"%s\"},", start, end, $2)
+}
+
+END {
+    print("// Code generated by gen.zsh; DO NOT EDIT\n\npackage unidata\n")
+
+    print("// Unicode version codepoints were first assigned in, sorted by codepoint.\n" \
+          "var ages = []struct {\n" \
+              "\trng [2]rune\n" \
+              "\tage string\n" \
+          "}{")
+    for (k in ages)
+        print("\t" ages[k])
+    print("}")
+}
@@ -0,0 +1,19 @@
+BEGIN        { FS = ";"
+               PROCINFO["sorted_in"] = "@ind_num_asc"
+             }
+/^$/ || /^#/ { next }
+
+{
+    cp = strtonum("0x" $1)
+    aliases[cp] = aliases[cp] sprintf("\"%s\", ", $2)
+}
+
+END {
+    print("// Code generated by gen.zsh; DO NOT EDIT\n\npackage unidata\n")
+
+    print("// Formal name aliases from NameAliases.txt, in the order they're listed.\n" \
+          "var nameAliases = map[rune][]string{")
+    for (k in aliases)
+        printf("\t0x%X: {%s},\n", k, gensub(", $", "", 1, aliases[k]))
+    print("}")
+}
@@ -0,0 +1,40 @@
+# Parse the rules from CLDR's Latin-ASCII transform; only simple rules for one
+# codepoint are used, for example:
+#
+#   Æ → AE ; # 00C6;LATIN CAPITAL LETTER AE
+#   ½ → ' 1/2' ;
+BEGIN        { FS = " → "
+               PROCINFO["sorted_in"] = "@ind_num_asc"
+               for (i = 0xa0; i < 0x30000; i++)
+                   ord[sprintf("%c", i)] = i
+             }
+/^#/ || !/ → / { next }
+
+{
+    if ($1 ~ /^\\u[0-9A-Fa-f]{4}$/)
+        cp = strtonum("0x" substr($1, 3))
+    else if ($1 in ord)
+        cp = ord[$1]
+    else
+        next
+
+    to = gensub(/ *;.*/, "", 1, $2)
+    if (to ~ /^'.*'$/)
+        to = substr(to, 2, length(to) - 2)
+    if (to ~ /[^\x20-\x7e]/)
+        next
+    gsub(/\\/, "\\\\", to)
+    gsub(/"/, "\\\"", to)
+    latin[cp] = to
+}
+
+END {
+    print("// Code generated by gen.zsh; DO NOT EDIT\n\npackage unidata\n")
+
+    print("// ASCII replacements for Latin letters and punctuation without a\n" \
+          "// decomposition, from CLDR's Latin-ASCII transform.\n" \
+          "var latinASCII = map[rune]string{")
+    for (k in latin)
+        printf("\t0x%X: \"%s\",\n", k, latin[k])
+    print("}")
+}
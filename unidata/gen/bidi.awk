@@ -0,0 +1,37 @@
+BEGIN        { FS = ";"
+               PROCINFO["sorted_in"] = "@ind_num_asc"
+             }
+
+{
+    cp = strtonum("0x" $1)
+    # Ranges are listed as "<CJK Ideograph, First>" and "<CJK Ideograph, Last>".
+    if ($2 ~ /, Last>$/) {
+        end[first] = cp
+        next
+    }
+    if (cp >= 0xD800 && cp <= 0xDFFF)
+        next
+
+    # Merge with the previous range if it's adjacent and the same class.
+    if (prev != "" && class[prev] == $5 && end[prev] == cp - 1) {
+        end[prev] = cp
+        first = prev
+        next
+    }
+    class[cp] = $5
+    end[cp] = cp
+    prev = first = cp
+}
+
+END {
+    print("// Code generated by gen.zsh; DO NOT EDIT\n\npackage unidata\n")
+
+    print("// Bidi_Class of assigned codepoints, sorted by codepoint.\n" \
+          "var bidiClasses = []struct {\n" \
+              "\trng   [2]rune\n" \
+              "\tclass string\n" \
+          "}{")
+    for (k in class)
+        printf("\t{[2]rune{0x%04X, 0x%04X}, \"%s\"},\n", k, end[k], class[k])
+    print("}")
+}
@@ -0,0 +1,31 @@
+BEGIN        { FS = ";"
+               PROCINFO["sorted_in"] = "@ind_num_asc"
+             }
+$4 == 0      { prev = ""; next }
+
+{
+    cp = strtonum("0x" $1)
+
+    # Merge with the previous range if it's adjacent and the same class.
+    if (prev != "" && ccc[prev] == $4 && end[prev] == cp - 1) {
+        end[prev] = cp
+        next
+    }
+    ccc[cp] = $4
+    end[cp] = cp
+    prev = cp
+}
+
+END {
+    print("// Code generated by gen.zsh; DO NOT EDIT\n\npackage unidata\n")
+
+    print("// Canonical_Combining_Class of codepoints where it isn't 0, sorted by\n" \
+          "// codepoint.\n" \
+          "var combiningClasses = []struct {\n" \
+              "\trng [2]rune\n" \
+              "\tccc uint8\n" \
+          "}{")
+    for (k in ccc)
+        printf("\t{[2]rune{0x%04X, 0x%04X}, %d},\n", k, end[k], ccc[k])
+    print("}")
+}
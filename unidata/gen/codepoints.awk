@@ -40,7 +40,7 @@ END {
 
     print("var Codepoints = map[rune]Codepoint{\n" codepoints "\n}\n")
 
-    print("var htmlEntities = map[rune]string{")
+    print("var htmlEntities = map[rune][]string{")
     while (getline line <".cache/entities.json" > 0) {
         split(line, fields, /["\[\]]/)
         ent = fields[2]
@@ -51,16 +51,20 @@ END {
             continue
         ent = gensub("^&|;$", "", "g", ent)
 
-        # Prefer entities without capitals and shorter entities.
-        # TODO: include all entities as a []string; select the first one with
-        # "%(html)" and all of them with "%(htmls)", "%(html_all)" or something.
-        if (!(cp in all) || (match(all[cp], /^[A-Z]/) && !match(ent, /^[A-Z]/)) || length(all[cp]) > length(ent))
-            all[cp] = ent
+        ents[cp] = (cp in ents) ? ents[cp] " " ent : ent
+    }
+    for (k in ents) {
+        n = split(ents[k], sorted, " ")
+        asort(sorted, sorted, "cmpent")
+        s = ""
+        for (i = 1; i <= n; i++)
+            s = s sprintf("\"%s\", ", sorted[i])
+        printf("\t0x%02x: {%s},\n", k, s)
     }
-    for (k in all) printf("\t0x%02x: \"%s\",\n", k, all[k])
     print("}\n")
 
     print("var keysyms = map[rune]string{")
+    delete all
     while (getline line <".cache/keysymdef.h" > 0) {
         if (match(line, "^#define XK") == 0)
             continue
@@ -71,6 +75,7 @@ END {
     print("}\n")
 
     print("var digraphs = map[rune]string{")
+    delete all
     while (getline line <".cache/rfc1345.txt" > 0) {
 		if (index(line, "ISO-IR-") > 0)
             continue
@@ -79,13 +84,34 @@ END {
             all[strtonum("0x" fields[2])] = gensub("\"", "\\\\\"", "g", fields[1])
         }
     }
+    # Vim has some alternative digraphs for codepoints that already have one;
+    # digraphs only stores one digraph per codepoint, so keep the others in
+    # digraphsAlt for the reverse lookup.
+    alt[all[0x20ac]] = 0x20ac # Eu from the RFC
+    alt["=P"]        = 0x20bd
     all[0x00]   = "NU" # Correct for inconsistent line
     all[0x20ac] = "=e" # € (Euro)
     all[0x20bd] = "=R" # ₽ (Ruble); also =P and the only one with more than one digraph :-/
     for (k in all) printf("\t0x%02x: \"%s\",\n", k, all[k])
+    print("}\n")
+
+    print("var digraphsAlt = map[string]rune{")
+    for (k in alt) printf("\t\"%s\": 0x%02x,\n", k, alt[k])
     print("}")
 }
 
+# Sort entities so that the first one is the "preferred" one: entities without
+# capitals and shorter entities come first.
+function cmpent(i1, v1, i2, v2,      u1, u2) {
+    u1 = match(v1, /^[A-Z]/)
+    u2 = match(v2, /^[A-Z]/)
+    if (u1 != u2)
+        return u1 - u2
+    if (length(v1) != length(v2))
+        return length(v1) - length(v2)
+    return v1 < v2 ? -1 : (v1 > v2)
+}
+
 function loadwidths(      fields, width, cp, start, end, i) {
     while (getline line <".cache/EastAsianWidth.txt" > 0) {
         if (match(line, "^$|^#") > 0)
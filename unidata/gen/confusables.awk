@@ -0,0 +1,26 @@
+BEGIN        { FS = ";"
+               PROCINFO["sorted_in"] = "@ind_num_asc"
+             }
+/^$/ || /^#/ || /^\xef\xbb\xbf/ { next }
+
+{
+    cp = strtonum("0x" gensub(/[ \t]/, "", "g", $1))
+    n = split(gensub(/^[ \t]+|[ \t]+$/, "", "g", $2), target, " ")
+    proto = ""
+    for (i = 1; i <= n; i++) {
+        t = strtonum("0x" target[i])
+        proto = proto (t > 0xffff ? sprintf("\\U%08X", t) : sprintf("\\u%04X", t))
+    }
+    confusables[cp] = proto
+}
+
+END {
+    print("// Code generated by gen.zsh; DO NOT EDIT\n\npackage unidata\n")
+
+    print("// Confusables from confusables.txt (UTS #39): the prototype a codepoint can\n" \
+          "// be confused with.\n" \
+          "var confusables = map[rune]string{")
+    for (k in confusables)
+        printf("\t0x%X: \"%s\",\n", k, confusables[k])
+    print("}")
+}
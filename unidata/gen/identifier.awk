@@ -0,0 +1,44 @@
+# Merges IdentifierStatus.txt and IdentifierType.txt from UTS #39.
+BEGIN        { FS = " *[;#] *"
+               PROCINFO["sorted_in"] = "@ind_num_asc"
+             }
+/^$/ || /^#/ { next }
+
+{
+    split($1, se, /\.\./)
+    start = strtonum("0x" se[1])
+    end   = se[2] == "" ? start : strtonum("0x" se[2])
+
+    if ($2 == "Allowed") {
+        allowed[start] = end
+        next
+    }
+
+    # Merge with the previous range if it's adjacent and the same type.
+    if (prev != "" && types[prev] == $2 && tend[prev] == start - 1) {
+        tend[prev] = end
+        next
+    }
+    types[start] = $2
+    tend[start] = end
+    prev = start
+}
+
+END {
+    print("// Code generated by gen.zsh; DO NOT EDIT\n\npackage unidata\n")
+
+    print("// Identifier_Status=Allowed from IdentifierStatus.txt, sorted by codepoint.\n" \
+          "var identifierAllowed = [][2]rune{")
+    for (k in allowed)
+        printf("\t{0x%04X, 0x%04X},\n", k, allowed[k])
+    print("}\n")
+
+    print("// Identifier_Type from IdentifierType.txt, sorted by codepoint.\n" \
+          "var identifierTypes = []struct {\n" \
+              "\trng   [2]rune\n" \
+              "\ttypes string\n" \
+          "}{")
+    for (k in types)
+        printf("\t{[2]rune{0x%04X, 0x%04X}, \"%s\"},\n", k, tend[k], types[k])
+    print("}")
+}
@@ -0,0 +1,41 @@
+BEGIN        { FS = " *[;#] *"
+               PROCINFO["sorted_in"] = "@ind_num_asc"
+               status["valid"]                  = "IDNAValid"
+               status["ignored"]                = "IDNAIgnored"
+               status["mapped"]                 = "IDNAMapped"
+               status["deviation"]              = "IDNADeviation"
+               status["disallowed"]             = "IDNADisallowed"
+               status["disallowed_STD3_valid"]  = "IDNADisallowedSTD3Valid"
+               status["disallowed_STD3_mapped"] = "IDNADisallowedSTD3Mapped"
+             }
+/^$/ || /^#/ { next }
+
+{
+    split($1, se, /\.\./)
+    start = strtonum("0x" se[1])
+    end   = se[2] == "" ? start : strtonum("0x" se[2])
+
+    mapping = ""
+    if ($2 != "valid") {
+        n = split($3, target, " ")
+        for (i = 1; i <= n; i++) {
+            t = strtonum("0x" target[i])
+            mapping = mapping (t > 0xffff ? sprintf("\\U%08X", t) : sprintf("\\u%04X", t))
+        }
+    }
+    idna[start] = sprintf("{[2]rune{0x%04X, 0x%04X}, %s, \"%s\"},", start, end, status[$2], mapping)
+}
+
+END {
+    print("// Code generated by gen.zsh; DO NOT EDIT\n\npackage unidata\n")
+
+    print("// UTS #46 IDNA mapping table, sorted by codepoint.\n" \
+          "var idnaMapping = []struct {\n" \
+              "\trng     [2]rune\n" \
+              "\tstatus  IDNAStatus\n" \
+              "\tmapping string\n" \
+          "}{")
+    for (k in idna)
+        print("\t" idna[k])
+    print("}")
+}
@@ -0,0 +1,30 @@
+BEGIN        { FS = " *[;#] *"
+               PROCINFO["sorted_in"] = "@ind_num_asc"
+             }
+/^$/ || /^#/ { next }
+
+{
+    cp = strtonum("0x" $1)
+
+    # Merge with the previous range if it's adjacent and the same type.
+    if (prev != "" && jt[prev] == $3 && end[prev] == cp - 1) {
+        end[prev] = cp
+        next
+    }
+    jt[cp] = $3
+    end[cp] = cp
+    prev = cp
+}
+
+END {
+    print("// Code generated by gen.zsh; DO NOT EDIT\n\npackage unidata\n")
+
+    print("// Joining_Type from ArabicShaping.txt, sorted by codepoint.\n" \
+          "var joiningTypes = []struct {\n" \
+              "\trng [2]rune\n" \
+              "\tjt  string\n" \
+          "}{")
+    for (k in jt)
+        printf("\t{[2]rune{0x%04X, 0x%04X}, \"%s\"},\n", k, end[k], jt[k])
+    print("}")
+}
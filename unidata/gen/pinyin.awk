@@ -0,0 +1,21 @@
+BEGIN        { FS = "\t"
+               PROCINFO["sorted_in"] = "@ind_num_asc"
+             }
+$2 != "kMandarin" { next }
+
+{
+    # The first reading is the most common one.
+    split($3, r, " ")
+    pinyin[strtonum("0x" substr($1, 3))] = r[1]
+}
+
+END {
+    print("// Code generated by gen.zsh; DO NOT EDIT\n\npackage unidata\n")
+
+    print("// Most common Mandarin reading in Hanyu Pinyin, from the kMandarin field in\n" \
+          "// Unihan_Readings.txt.\n" \
+          "var pinyin = map[rune]string{")
+    for (k in pinyin)
+        printf("\t0x%X: \"%s\",\n", k, pinyin[k])
+    print("}")
+}
@@ -0,0 +1,90 @@
+BEGIN        { FS = ";" }
+
+$6 ~ /^<(font|circle|square|wide|super|sub)> / {
+    cp  = strtonum("0x" $1)
+    tag = gensub(/^<([a-z]+)> .*/, "\\1", 1, $6)
+    n   = split(gensub(/^<[a-z]+> /, "", 1, $6), target, " ")
+
+    plain = ""
+    for (i = 1; i <= n; i++) {
+        t = strtonum("0x" target[i])
+        plain = plain (t > 0xffff ? sprintf("\\U%08X", t) : sprintf("\\u%04X", t))
+    }
+    unstyled[cp] = plain
+    if (n > 1)
+        next
+
+    # Lower rank is preferred if there's more than one codepoint for a style;
+    # the Letterlike Symbols block fills the holes in the Mathematical
+    # Alphanumeric Symbols block.
+    rank = 0
+    if (tag == "font") {
+        if (cp >= 0x1D400 && cp <= 0x1D7FF) {
+            style = tolower(gensub(/^MATHEMATICAL ((BOLD |ITALIC |SCRIPT |FRAKTUR |DOUBLE-STRUCK |SANS-SERIF |MONOSPACE )+).*/, "\\1", 1, $2))
+            sub(/ $/, "", style)
+            sub(/sans-serif/, "sans", style)
+            gsub(/ /, "-", style)
+        }
+        else if ($2 ~ /^SCRIPT (CAPITAL|SMALL) /)        { style = "script";        rank = 1 }
+        else if ($2 ~ /^BLACK-LETTER (CAPITAL|SMALL) /)  { style = "fraktur";       rank = 1 }
+        else if ($2 ~ /^DOUBLE-STRUCK (CAPITAL|SMALL) /) { style = "double-struck"; rank = 1 }
+        else if ($2 == "PLANCK CONSTANT")                { style = "italic";        rank = 1 }
+        else
+            next
+    }
+    else if (tag == "circle") style = "circled"
+    else if (tag == "square") style = "squared"
+    else if (tag == "wide")   style = "fullwidth"
+    else if (tag == "super") {
+        style = "superscript"
+        rank  = $2 ~ /^SUPERSCRIPT / ? 0 : $2 ~ /^MODIFIER LETTER / ? 1 : 2
+    }
+    else if (tag == "sub") {
+        style = "subscript"
+        rank  = $2 ~ /SUBSCRIPT / ? 0 : 1
+    }
+
+    t = strtonum("0x" target[1])
+    if (!(style in styles) || !(t in styles[style]) || rank < ranks[style][t]) {
+        styles[style][t] = cp
+        ranks[style][t]  = rank
+    }
+}
+
+# Small capitals don't have a decomposition, so get them from the name.
+$2 ~ /^LATIN LETTER SMALL CAPITAL [A-Z]$/ {
+    smallcaps[tolower(substr($2, length($2)))] = strtonum("0x" $1)
+}
+
+END {
+    print("// Code generated by gen.zsh; DO NOT EDIT\n\npackage unidata\n")
+
+    print("// Styled codepoints from the <font>, <circle>, <square>, <wide>, <super>, and\n" \
+          "// <sub> decompositions in UnicodeData.txt.\n" \
+          "var styles = map[string]map[rune]rune{")
+    PROCINFO["sorted_in"] = "@ind_str_asc"
+    for (s in styles) {
+        printf("\t\"%s\": {\n", s)
+        PROCINFO["sorted_in"] = "@ind_num_asc"
+        for (k in styles[s])
+            printf("\t\t0x%X: 0x%X,\n", k, styles[s][k])
+        PROCINFO["sorted_in"] = "@ind_str_asc"
+        print("\t},")
+    }
+    print("}\n")
+
+    print("// Plain text for all styled codepoints.\n" \
+          "var unstyled = map[rune]string{")
+    PROCINFO["sorted_in"] = "@ind_num_asc"
+    for (k in unstyled)
+        printf("\t0x%X: \"%s\",\n", k, unstyled[k])
+    print("}\n")
+
+    print("// Small capitals from the \"LATIN LETTER SMALL CAPITAL x\" names in\n" \
+          "// UnicodeData.txt.\n" \
+          "var smallCaps = map[rune]rune{")
+    PROCINFO["sorted_in"] = "@ind_str_asc"
+    for (k in smallcaps)
+        printf("\t'%s': 0x%X,\n", k, smallcaps[k])
+    print("}")
+}
@@ -0,0 +1,36 @@
+BEGIN        { FS = "\t"
+               PROCINFO["sorted_in"] = "@ind_num_asc"
+               names["kSimplifiedVariant"]  = "simplified"
+               names["kTraditionalVariant"] = "traditional"
+               names["kZVariant"]           = "zVariants"
+               names["kSemanticVariant"]    = "semanticVariants"
+             }
+!($2 in names) { next }
+
+{
+    cp = strtonum("0x" substr($1, 3))
+    n = split($3, target, " ")
+    v = ""
+    for (i = 1; i <= n; i++) {
+        # Semantic variants can have the source: U+5154<kMatthews
+        t = strtonum("0x" substr(gensub(/<.*/, "", 1, target[i]), 3))
+        v = v (t > 0xffff ? sprintf("\\U%08X", t) : sprintf("\\u%04X", t))
+    }
+    variants[names[$2]][cp] = v
+}
+
+END {
+    print("// Code generated by gen.zsh; DO NOT EDIT\n\npackage unidata\n")
+
+    print("// Variants of CJK ideographs from the kSimplifiedVariant, kTraditionalVariant,\n" \
+          "// kZVariant, and kSemanticVariant fields in Unihan_Variants.txt.\n" \
+          "var (")
+    split("simplified traditional zVariants semanticVariants", order, " ")
+    for (i = 1; i <= 4; i++) {
+        printf("\t%s = map[rune]string{\n", order[i])
+        for (k in variants[order[i]])
+            printf("\t\t0x%X: \"%s\",\n", k, variants[order[i]][k])
+        print("\t}")
+    }
+    print(")")
+}
@@ -0,0 +1,28 @@
+package unidata
+
+import (
+	"sort"
+	"strings"
+)
+
+// IdentifierStatus gets the Identifier_Status from UTS #39: "Allowed" for
+// codepoints that are recommended in identifiers, or "Restricted" for
+// everything else.
+func (c Codepoint) IdentifierStatus() string {
+	i := sort.Search(len(identifierAllowed), func(i int) bool { return identifierAllowed[i][1] >= c.Codepoint })
+	if i < len(identifierAllowed) && identifierAllowed[i][0] <= c.Codepoint {
+		return "Allowed"
+	}
+	return "Restricted"
+}
+
+// IdentifierType gets the Identifier_Type values from UTS #39, such as
+// "Recommended", "Inclusion", "Technical", or "Not_XID"; a codepoint can have
+// more than one type, e.g. "Technical" and "Obsolete".
+func (c Codepoint) IdentifierType() []string {
+	i := sort.Search(len(identifierTypes), func(i int) bool { return identifierTypes[i].rng[1] >= c.Codepoint })
+	if i < len(identifierTypes) && identifierTypes[i].rng[0] <= c.Codepoint {
+		return strings.Fields(identifierTypes[i].types)
+	}
+	return []string{"Not_Character"}
+}
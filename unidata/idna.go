@@ -0,0 +1,84 @@
+package unidata
+
+import "sort"
+
+// IDNAStatus is the status of a codepoint in the UTS #46 IDNA mapping table.
+type IDNAStatus uint8
+
+// IDNA statuses.
+const (
+	IDNADisallowed           IDNAStatus = iota // Not allowed.
+	IDNAValid                                  // Allowed as-is.
+	IDNAIgnored                                // Removed.
+	IDNAMapped                                 // Replaced with the mapping.
+	IDNADeviation                              // Valid in IDNA2008, but mapped in transitional processing.
+	IDNADisallowedSTD3Valid                    // Valid, unless STD3 ASCII rules are used.
+	IDNADisallowedSTD3Mapped                   // Mapped, unless STD3 ASCII rules are used.
+)
+
+func (s IDNAStatus) String() string {
+	switch s {
+	case IDNAValid:
+		return "valid"
+	case IDNAIgnored:
+		return "ignored"
+	case IDNAMapped:
+		return "mapped"
+	case IDNADeviation:
+		return "deviation"
+	case IDNADisallowedSTD3Valid:
+		return "disallowed_STD3_valid"
+	case IDNADisallowedSTD3Mapped:
+		return "disallowed_STD3_mapped"
+	default:
+		return "disallowed"
+	}
+}
+
+// IDNA gets the status in the UTS #46 IDNA mapping table, and the mapping for
+// mapped and deviation codepoints (the mapping can be an empty string for
+// deviations).
+func (c Codepoint) IDNA() (IDNAStatus, string) {
+	i := sort.Search(len(idnaMapping), func(i int) bool { return idnaMapping[i].rng[1] >= c.Codepoint })
+	if i < len(idnaMapping) && idnaMapping[i].rng[0] <= c.Codepoint {
+		return idnaMapping[i].status, idnaMapping[i].mapping
+	}
+	return IDNADisallowed, ""
+}
+
+// BidiClass gets the short name of the Bidi_Class, such as "L" for
+// Left_To_Right or "AL" for Arabic_Letter. Unassigned codepoints are "L".
+func (c Codepoint) BidiClass() string {
+	i := sort.Search(len(bidiClasses), func(i int) bool { return bidiClasses[i].rng[1] >= c.Codepoint })
+	if i < len(bidiClasses) && bidiClasses[i].rng[0] <= c.Codepoint {
+		return bidiClasses[i].class
+	}
+	return "L"
+}
+
+// CombiningClass gets the Canonical_Combining_Class; for example 9 is a
+// virama and 230 is a mark above the base character.
+func (c Codepoint) CombiningClass() uint8 {
+	i := sort.Search(len(combiningClasses), func(i int) bool { return combiningClasses[i].rng[1] >= c.Codepoint })
+	if i < len(combiningClasses) && combiningClasses[i].rng[0] <= c.Codepoint {
+		return combiningClasses[i].ccc
+	}
+	return 0
+}
+
+// JoiningType gets the short name of the Joining_Type, which is used for
+// cursive scripts such as Arabic: "D" (dual joining), "L" (left joining), "R"
+// (right joining), "C" (join causing), "T" (transparent), or "U" (non joining).
+func (c Codepoint) JoiningType() string {
+	i := sort.Search(len(joiningTypes), func(i int) bool { return joiningTypes[i].rng[1] >= c.Codepoint })
+	if i < len(joiningTypes) && joiningTypes[i].rng[0] <= c.Codepoint {
+		return joiningTypes[i].jt
+	}
+
+	// Marks and format characters that aren't listed are transparent.
+	info, _ := Find(c.Codepoint)
+	if cat := info.Category(); cat == CatNonspacingMark || cat == CatEnclosingMark || cat == CatFormat {
+		return "T"
+	}
+	return "U"
+}
@@ -0,0 +1,67 @@
+package unidata
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// Styles gets a list of all styles for Style().
+func Styles() []string {
+	s := make([]string, 0, len(styles)+1)
+	for k := range styles {
+		s = append(s, k)
+	}
+	s = append(s, "small-caps")
+	sort.Strings(s)
+	return s
+}
+
+// Style the text with the "styled" codepoints for bold, italic, fraktur, etc.
+//
+// Codepoints that don't have a styled variant are left as-is; lower case
+// letters are styled as upper case if there is only an upper case variant (as
+// with "squared"). It returns false if the style doesn't exist.
+func Style(style, s string) (string, bool) {
+	m, ok := styles[style]
+	if style == "small-caps" {
+		m, ok = smallCaps, true
+	}
+	if !ok {
+		return "", false
+	}
+
+	return strings.Map(func(r rune) rune {
+		if st, ok := m[r]; ok {
+			return st
+		}
+		if st, ok := m[unicode.ToUpper(r)]; ok {
+			return st
+		}
+		return r
+	}, s), true
+}
+
+// Unstyle replaces all styled codepoints with the plain text; this is the
+// reverse of Style().
+//
+// This also replaces other codepoints with a <font>, <circle>, <square>,
+// <wide>, <super>, or <sub> decomposition, such as ª and ⑳.
+func Unstyle(s string) string {
+	b := new(strings.Builder)
+	b.Grow(len(s))
+	for _, r := range s {
+		if p, ok := unstyled[r]; ok {
+			b.WriteString(p)
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+func init() {
+	for k, v := range smallCaps {
+		unstyled[v] = string(k)
+	}
+}
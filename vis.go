@@ -0,0 +1,88 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"zgo.at/uni/v2/unidata"
+	"zgo.at/zli"
+)
+
+// vis prints the text with invisible and confusing characters replaced by
+// visible markers, like "cat -A" but for Unicode.
+func vis(args []string, lookalikes bool) error {
+	b := new(strings.Builder)
+	runes := []rune(strings.Join(args, " "))
+	for i, r := range runes {
+		var prev, next rune
+		if i > 0 {
+			prev = runes[i-1]
+		}
+		if i < len(runes)-1 {
+			next = runes[i+1]
+		}
+
+		if m := visMarker(prev, r, next); m != "" {
+			b.WriteString(zli.Colorize("⟨"+m+"⟩", zli.Red|zli.Bold))
+			continue
+		}
+		if lookalikes && r > 0x7f {
+			if c := (unidata.Codepoint{Codepoint: r}).Confusable(); isASCII(c) {
+				b.WriteString(zli.Colorize(fmt.Sprintf("⟨U+%04X≈%s⟩", r, c), zli.Yellow|zli.Bold))
+				continue
+			}
+		}
+		b.WriteRune(r)
+	}
+	fmt.Fprintln(zli.Stdout, b.String())
+	return nil
+}
+
+// isASCII reports if s is non-empty and only contains ASCII.
+func isASCII(s string) bool {
+	for _, c := range s {
+		if c > 0x7f {
+			return false
+		}
+	}
+	return s != ""
+}
+
+// visMarker gets the marker to display for a codepoint, or "" if it can be
+// displayed as-is.
+func visMarker(prev, r, next rune) string {
+	switch {
+	case r == '\n' || r == '\t' || (r >= 0x20 && r < 0x7f):
+		return ""
+	case r < 0x20 || (r >= 0x7f && r <= 0x9f),
+		bidiControl.contains(r),
+		defaultIgnorable.contains(r) && !ignorableOK(prev, r, next):
+	default:
+		// Find() returns !ok for unassigned codepoints.
+		info, ok := unidata.Find(r)
+		switch cat := info.Category(); {
+		case !ok, cat == unidata.CatSpaceSeparator, cat == unidata.CatLineSeparator,
+			cat == unidata.CatParagraphSeparator, cat == unidata.CatUnassigned, cat == unidata.CatPrivateUse:
+		default:
+			return ""
+		}
+	}
+	return abbrev(r)
+}
+
+// abbrev gets a short name for the codepoint: the shortest abbreviation from
+// the name aliases (ZWSP, NBSP, RLO, etc.), or U+XXXX if there isn't any.
+func abbrev(r rune) string {
+	info, _ := unidata.Find(r)
+	short := ""
+	for _, a := range info.Aliases() {
+		if len(a) <= 6 && strings.ToUpper(a) == a && !strings.Contains(a, " ") &&
+			(short == "" || len(a) < len(short)) {
+			short = a
+		}
+	}
+	if short == "" {
+		return info.FormatCodepoint()
+	}
+	return short
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"zgo.at/uni/v2/unidata"
+	"zgo.at/zli"
+)
+
+// zh converts between simplified and traditional Chinese.
+//
+// This is character-by-character, so it can't pick the right conversion if
+// there is more than one (e.g. 发 is 發 in 發現 but 髮 in 頭髮); these are
+// reported as ambiguous, and the codepoint is left as-is if it's one of the
+// options or converted to the first option if it's not.
+func zh(args []string, to string, quiet bool) error {
+	if to == "" {
+		return errors.New("zh: need -to simplified or -to traditional")
+	}
+	target, err := match(to, "simplified", "traditional")
+	if err != nil {
+		return fmt.Errorf("unknown value for -to: %q; need simplified or traditional", to)
+	}
+
+	var (
+		text = strings.Join(args, " ")
+		b    = new(strings.Builder)
+		ch   = make(changes)
+	)
+	b.Grow(len(text))
+	for _, r := range text {
+		if r < 0x2e80 { // Common case.
+			b.WriteRune(r)
+			continue
+		}
+
+		info := unidata.Codepoint{Codepoint: r}
+		v := info.Traditional()
+		if target == "simplified" {
+			v = info.Simplified()
+		}
+
+		switch {
+		case v == "":
+			b.WriteRune(r)
+		case strings.ContainsRune(v, r):
+			ch.add("ambiguous", string(r), v)
+			b.WriteRune(r)
+		default:
+			first := []rune(v)[0]
+			if len(v) > len(string(first)) {
+				ch.add("ambiguous", string(r), v)
+			} else {
+				ch.add("replaced", string(r), v)
+			}
+			b.WriteRune(first)
+		}
+	}
+
+	fmt.Fprintln(zli.Stdout, b.String())
+	if !quiet {
+		ch.summary(zli.Stderr, "zh")
+	}
+	return nil
+}